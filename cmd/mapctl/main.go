@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+	"github.com/amikhailau/medieval-game-server/pkg/tiled"
+)
+
+const usage = `usage: mapctl <command> [flags] <map file>
+
+commands:
+  validate  check map description for problems breaking game sessions
+  render    draw svg preview of map
+  import    convert Tiled json map to map description
+  export    convert map description to Tiled json map
+`
+
+// mapctl validates and previews map descriptions
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	switch os.Args[1] {
+	case "validate":
+		validate(os.Args[2:])
+	case "render":
+		render(os.Args[2:])
+	case "import":
+		importTiled(os.Args[2:])
+	case "export":
+		exportTiled(os.Args[2:])
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+// loadMap reads map of any version, seed places players in spawn zones of v2 maps
+func loadMap(filename string, playerRadius float32, seed int64) *gamesession.MapDescription {
+	mapDesc, err := gamesession.LoadMap(filename, playerRadius, seed)
+	if err != nil {
+		log.Fatalf("%v: %v", filename, err)
+	}
+	return mapDesc
+}
+
+func validate(args []string) {
+	flags := pflag.NewFlagSet("validate", pflag.ExitOnError)
+	radius := flags.Float32("radius", 5, "radius of player model")
+	seed := flags.Int64("seed", 1, "seed for placing players in spawn zones")
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		log.Fatalf("map file should be set")
+	}
+
+	failed := false
+	for _, filename := range flags.Args() {
+		err := gamesession.ValidateMap(loadMap(filename, *radius, *seed), *radius)
+		var validationErr *gamesession.MapValidationError
+		switch {
+		case err == nil:
+			fmt.Printf("%v: ok\n", filename)
+		case errors.As(err, &validationErr):
+			failed = true
+			for _, problem := range validationErr.Problems {
+				fmt.Printf("%v: %v\n", filename, problem)
+			}
+		default:
+			log.Fatalf("%v: %v", filename, err)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func render(args []string) {
+	flags := pflag.NewFlagSet("render", pflag.ExitOnError)
+	radius := flags.Float32("radius", 5, "radius of player model")
+	seed := flags.Int64("seed", 1, "seed for placing players in spawn zones")
+	scale := flags.Float32("scale", 5, "pixels per map unit")
+	output := flags.StringP("out", "o", "", "path to svg file, stdout if empty")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatalf("exactly one map file should be set")
+	}
+
+	out := createOutput(*output)
+	defer out.Close()
+	if err := renderSVG(out, loadMap(flags.Arg(0), *radius, *seed), *radius, *scale); err != nil {
+		log.Fatalf("unable to write svg: %v", err)
+	}
+}
+
+// createOutput opens file for command output or returns stdout if filename is empty
+func createOutput(filename string) *os.File {
+	if filename == "" {
+		return os.Stdout
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		log.Fatalf("unable to create output file: %v", err)
+	}
+	return file
+}
+
+func importTiled(args []string) {
+	flags := pflag.NewFlagSet("import", pflag.ExitOnError)
+	radius := flags.Float32("radius", 5, "radius of player model")
+	output := flags.StringP("out", "o", "", "path to map description file, stdout if empty")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatalf("exactly one tiled map file should be set")
+	}
+
+	data, err := ioutil.ReadFile(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("unable to read tiled map: %v", err)
+	}
+	mapDesc, err := tiled.Import(data)
+	if err != nil {
+		log.Fatalf("%v: %v", flags.Arg(0), err)
+	}
+	var validationErr *gamesession.MapValidationError
+	if err = gamesession.ValidateMap(mapDesc, *radius); errors.As(err, &validationErr) {
+		for _, problem := range validationErr.Problems {
+			log.Printf("%v: %v", flags.Arg(0), problem)
+		}
+	}
+
+	data, err = json.MarshalIndent(mapDesc, "", "    ")
+	if err != nil {
+		log.Fatalf("unable to marshal map description: %v", err)
+	}
+	out := createOutput(*output)
+	defer out.Close()
+	if _, err = out.Write(append(data, '\n')); err != nil {
+		log.Fatalf("unable to write map description: %v", err)
+	}
+}
+
+func exportTiled(args []string) {
+	flags := pflag.NewFlagSet("export", pflag.ExitOnError)
+	radius := flags.Float32("radius", 5, "radius of player model")
+	seed := flags.Int64("seed", 1, "seed for placing players in spawn zones")
+	output := flags.StringP("out", "o", "", "path to tiled map file, stdout if empty")
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		log.Fatalf("exactly one map file should be set")
+	}
+
+	data, err := tiled.Export(loadMap(flags.Arg(0), *radius, *seed))
+	if err != nil {
+		log.Fatalf("unable to export map: %v", err)
+	}
+	out := createOutput(*output)
+	defer out.Close()
+	if _, err = out.Write(append(data, '\n')); err != nil {
+		log.Fatalf("unable to write tiled map: %v", err)
+	}
+}
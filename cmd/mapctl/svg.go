@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+)
+
+var entityColors = map[string]string{
+	"":          "#555555",
+	"WALL":      "#555555",
+	"DOOR":      "#8b5a2b",
+	"BARRICADE": "#a0522d",
+	"CRATE":     "#d2a04a",
+}
+
+func svgPoints(vertexes []float64) string {
+	points := make([]string, 0, len(vertexes)/2)
+	for i := 0; i+1 < len(vertexes); i += 2 {
+		points = append(points, fmt.Sprintf("%g,%g", vertexes[i], vertexes[i+1]))
+	}
+	return strings.Join(points, " ")
+}
+
+// renderSVG draws map polygons, spawns and loot spots, map coordinates are used as svg user units
+func renderSVG(w io.Writer, mapDesc *gamesession.MapDescription, playerRadius, scale float32) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%g\" height=\"%g\" viewBox=\"0 0 %g %g\">\n",
+		mapDesc.MapBorderX*scale, mapDesc.MapBorderY*scale, mapDesc.MapBorderX, mapDesc.MapBorderY)
+	fmt.Fprintf(&b, "<rect x=\"0\" y=\"0\" width=\"%g\" height=\"%g\" fill=\"#e8e8e0\"/>\n", mapDesc.MapBorderX, mapDesc.MapBorderY)
+	for _, terrain := range mapDesc.Terrain {
+		fmt.Fprintf(&b, "<polygon points=\"%v\" fill=\"#c9b77a\" fill-opacity=\"0.5\"/>\n", svgPoints(terrain.Vertexes))
+	}
+	for _, concealment := range mapDesc.Concealment {
+		fmt.Fprintf(&b, "<polygon points=\"%v\" fill=\"#2e7d32\" fill-opacity=\"0.6\"/>\n", svgPoints(concealment.Vertexes))
+	}
+	for _, hazard := range mapDesc.Hazards {
+		fmt.Fprintf(&b, "<polygon points=\"%v\" fill=\"#e53935\" fill-opacity=\"0.4\"><title>%v</title></polygon>\n", svgPoints(hazard.Vertexes), hazard.Name)
+	}
+	for i, polygon := range mapDesc.Polygons {
+		color, ok := entityColors[polygon.Type]
+		if !ok {
+			color = "#ff00ff"
+		}
+		title := fmt.Sprintf("entity %v", i)
+		if polygon.Name != "" {
+			title = polygon.Name
+		}
+		if polygon.Type != "" {
+			title += " " + polygon.Type
+		}
+		fmt.Fprintf(&b, "<polygon points=\"%v\" fill=\"%v\"><title>%v</title></polygon>\n", svgPoints(polygon.Vertexes), color, title)
+	}
+	if zone := mapDesc.SafeZone; zone != nil && len(zone.Center) == 2 {
+		fmt.Fprintf(&b, "<circle cx=\"%g\" cy=\"%g\" r=\"%g\" fill=\"none\" stroke=\"#d32f2f\" stroke-width=\"0.5\" stroke-dasharray=\"2 1\"/>\n",
+			zone.Center[0], zone.Center[1], zone.Radius)
+	}
+	for i := 0; i+1 < len(mapDesc.LootSpawns); i += 2 {
+		fmt.Fprintf(&b, "<circle cx=\"%g\" cy=\"%g\" r=\"1.5\" fill=\"#ffb300\"><title>loot spot %v</title></circle>\n",
+			mapDesc.LootSpawns[i], mapDesc.LootSpawns[i+1], i/2)
+	}
+	for i := 0; i+1 < len(mapDesc.PlayerSpawns); i += 2 {
+		fmt.Fprintf(&b, "<circle cx=\"%g\" cy=\"%g\" r=\"%g\" fill=\"#1976d2\" fill-opacity=\"0.7\"><title>player spawn %v</title></circle>\n",
+			mapDesc.PlayerSpawns[i], mapDesc.PlayerSpawns[i+1], playerRadius, i/2)
+	}
+	b.WriteString("</svg>\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/amikhailau/medieval-game-server/pkg/replay"
+)
+
+var (
+	flagReplayFile = pflag.String("file", "", "path to replay file")
+	flagDump       = pflag.Bool("dump", false, "print game state of every tick as json")
+	flagSeek       = pflag.Int64("seek", -1, "print game state of given tick as json and stop, -1 replays the whole match")
+)
+
+// replay re-simulates recorded match and verifies it against recorded keyframes
+func main() {
+	pflag.Parse()
+	if *flagReplayFile == "" {
+		log.Fatalf("replay file should be set")
+	}
+
+	reader, err := replay.Open(*flagReplayFile)
+	if err != nil {
+		log.Fatalf("unable to open replay: %v", err)
+	}
+	defer reader.Close()
+	playback, err := replay.NewPlayback(reader)
+	if err != nil {
+		log.Fatalf("unable to start playback: %v", err)
+	}
+
+	var lastTick int64
+	for {
+		tick, err := playback.Step()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Fatalf("replay verification failed: %v", err)
+		}
+		lastTick = tick.Tick
+		if *flagDump || tick.Tick == *flagSeek {
+			state, err := protojson.Marshal(playback.Session.StateProto())
+			if err != nil {
+				log.Fatalf("unable to marshal game state: %v", err)
+			}
+			fmt.Printf("{\"tick\":%d,\"state\":%s}\n", tick.Tick, state)
+		}
+		if tick.Tick == *flagSeek {
+			return
+		}
+	}
+	if *flagSeek >= 0 {
+		log.Fatalf("tick %v is not present in replay, last tick: %v", *flagSeek, lastTick)
+	}
+	log.Printf("replay verified, ticks simulated: %v", lastTick)
+}
@@ -7,15 +7,60 @@ import (
 )
 
 const (
-	defaultGameStatesSaved     = 5
+	defaultGameStatesSaved     = 10
 	defaultGameStatesShiftBack = 1
 	defaultTicksPerSecond      = 30
 	defaultPlayerCount         = 2
 	defaultPlayerPickUpRange   = 10
 	defaultPlayerDropRange     = 15
 	defaultPlayerRadius        = 5
+	defaultConsumableSlots     = 2
+	defaultPlayerStamina       = 100
+	defaultStaminaRegen        = 20
+	defaultAttackStaminaCost   = 0
+	defaultBlockStaminaCost    = 0
+	defaultAttackCooldowns     = false
+	defaultBlockCone           = 0.79
+	defaultBlockReduction      = 0.75
+	defaultParryWindow         = 0.2
+	defaultStaggerDuration     = 1
+	defaultPlayerMaxSpeed      = 0
+	defaultSprintMultiplier    = 1.5
+	defaultPlayerAcceleration  = 0
+	defaultPlayerCollision     = true
+	defaultTeamSize            = 1
+	defaultFriendlyFire        = false
+	defaultKnockdownHp         = 50
+	defaultKnockdownBleed      = 5
+	defaultReviveDuration      = 5
+	defaultReviveRange         = 15
+	defaultReviveHp            = 30
+	defaultGameMode            = "battle_royale"
+	defaultMatchDuration       = 300
+	defaultScoreLimit          = 20
+	defaultRespawnDelay        = 3
+	defaultSpawnProtection     = 2
+	defaultInterpolationDelay  = 0.1
+	defaultMaxRewind           = 0.25
+	defaultPingInterval        = time.Second
+	defaultViewRadius          = 0
+	defaultViewOcclusion       = false
 	defaultMapFilePath         = "test/testmap.json"
+	defaultMapPoolPath         = ""
+	defaultLootTablesFilePath  = "test/loottables.json"
+	defaultLootSeed            = 0
+	defaultArmorFormula        = "flat"
+	defaultArmorCurve          = 50
+	defaultHeadshotCone        = 0
+	defaultHeadshotMultiplier  = 1.5
+	defaultBackstabCone        = 0
+	defaultBackstabMultiplier  = 2
+	defaultCritChance          = 0
+	defaultCritMultiplier      = 1.5
+	defaultMinDamage           = 0
 	defaultPortToAcceptConns   = 9979
+	defaultReplayFilePath      = ""
+	defaultReplayKeyframes     = 30
 
 	defaultEnableUsersServiceUpdate       = false
 	defaultUsersServiceAddress            = "https://users-service-medieval.herokuapp.com"
@@ -42,8 +87,53 @@ var (
 	flagPlayerPickUpRange   = pflag.Float32("gamesession.player.pickup", defaultPlayerPickUpRange, "range of player item pick up")
 	flagPlayerDropRange     = pflag.Float32("gamesession.player.drop", defaultPlayerDropRange, "range of player item drop")
 	flagPlayerRadius        = pflag.Int("gamesession.player.radius", defaultPlayerRadius, "radius of player model")
+	flagConsumableSlots     = pflag.Int("gamesession.player.consumables", defaultConsumableSlots, "amount of consumable slots of player")
+	flagPlayerStamina       = pflag.Float32("gamesession.player.stamina", defaultPlayerStamina, "max stamina of player")
+	flagStaminaRegen        = pflag.Float32("gamesession.player.stamina_regen", defaultStaminaRegen, "stamina regenerated per second")
+	flagAttackStaminaCost   = pflag.Float32("gamesession.attack.stamina", defaultAttackStaminaCost, "stamina spent on attack, attacks are free if 0")
+	flagBlockStaminaCost    = pflag.Float32("gamesession.block.stamina", defaultBlockStaminaCost, "stamina spent per second of blocking, blocking is free if 0")
+	flagAttackCooldowns     = pflag.Bool("gamesession.attack.cooldowns", defaultAttackCooldowns, "enforce attack interval and wind up of weapons")
+	flagBlockCone           = pflag.Float32("gamesession.block.cone", defaultBlockCone, "half angle of frontal cone covered by block")
+	flagBlockReduction      = pflag.Float32("gamesession.block.reduction", defaultBlockReduction, "part of damage negated by block")
+	flagParryWindow         = pflag.Float32("gamesession.block.parry", defaultParryWindow, "seconds after block start when attack is parried")
+	flagStaggerDuration     = pflag.Float32("gamesession.block.stagger", defaultStaggerDuration, "seconds attacker is staggered after parry")
+	flagPlayerMaxSpeed      = pflag.Float32("gamesession.player.speed", defaultPlayerMaxSpeed, "max speed of player per second, unlimited if 0")
+	flagSprintMultiplier    = pflag.Float32("gamesession.player.sprint", defaultSprintMultiplier, "speed multiplier of sprinting player")
+	flagPlayerAcceleration  = pflag.Float32("gamesession.player.acceleration", defaultPlayerAcceleration, "acceleration of player per second, instant if 0")
+	flagPlayerCollision     = pflag.Bool("gamesession.player.collision", defaultPlayerCollision, "push apart overlapping players")
+	flagTeamSize            = pflag.Int("gamesession.team.size", defaultTeamSize, "players in one team, free for all if 1")
+	flagFriendlyFire        = pflag.Bool("gamesession.team.friendly_fire", defaultFriendlyFire, "allow players to damage teammates")
+	flagKnockdownHp         = pflag.Int32("gamesession.team.knockdown_hp", defaultKnockdownHp, "hp of knocked down player, knockdowns are disabled if 0")
+	flagKnockdownBleed      = pflag.Float32("gamesession.team.bleed", defaultKnockdownBleed, "hp lost per second by knocked down player")
+	flagReviveDuration      = pflag.Float32("gamesession.team.revive_duration", defaultReviveDuration, "seconds needed to revive teammate")
+	flagReviveRange         = pflag.Float32("gamesession.team.revive_range", defaultReviveRange, "max distance between reviving teammates")
+	flagReviveHp            = pflag.Int32("gamesession.team.revive_hp", defaultReviveHp, "hp of revived player")
+	flagGameMode            = pflag.String("gamesession.mode", defaultGameMode, "game mode: battle_royale or deathmatch")
+	flagMatchDuration       = pflag.Float32("gamesession.deathmatch.duration", defaultMatchDuration, "deathmatch duration in seconds, unlimited if 0")
+	flagScoreLimit          = pflag.Int32("gamesession.deathmatch.score_limit", defaultScoreLimit, "kills needed to win deathmatch, unlimited if 0")
+	flagRespawnDelay        = pflag.Float32("gamesession.deathmatch.respawn", defaultRespawnDelay, "seconds before dead player respawns")
+	flagSpawnProtection     = pflag.Float32("gamesession.deathmatch.protection", defaultSpawnProtection, "seconds of invulnerability after respawn")
+	flagInterpolationDelay  = pflag.Float32("gamesession.lag.interpolation", defaultInterpolationDelay, "seconds clients render game state behind received one")
+	flagMaxRewind           = pflag.Float32("gamesession.lag.max_rewind", defaultMaxRewind, "max seconds attacks are rewound by latency of attacker, lag compensation is disabled if 0")
+	flagViewRadius          = pflag.Float32("gamesession.view.radius", defaultViewRadius, "radius of area seen by player, state is not filtered if 0")
+	flagViewOcclusion       = pflag.Bool("gamesession.view.occlusion", defaultViewOcclusion, "hide entities behind map polygons from player")
+	flagPingInterval        = pflag.Duration("gamemanager.ping.interval", defaultPingInterval, "interval between round trip time measurements of clients, disabled if 0")
 	flagMapFilePath         = pflag.String("gamemanager.map.file", defaultMapFilePath, "path to map description")
+	flagMapPoolPath         = pflag.String("gamemanager.map.pool", defaultMapPoolPath, "path to map pool directory, map is chosen by allocation metadata or rotation, map file is used if empty")
+	flagLootTablesFilePath  = pflag.String("gamesession.loot.file", defaultLootTablesFilePath, "path to loot tables description")
+	flagLootSeed            = pflag.Int64("gamesession.loot.seed", defaultLootSeed, "seed for loot generation, random if 0")
+	flagArmorFormula        = pflag.String("gamesession.damage.armor", defaultArmorFormula, "armor mitigation formula: flat subtracts armor from damage, percent negates armor / (armor + curve) of damage")
+	flagArmorCurve          = pflag.Float32("gamesession.damage.armor_curve", defaultArmorCurve, "armor which negates half of damage with percent formula")
+	flagHeadshotCone        = pflag.Float32("gamesession.damage.headshot_cone", defaultHeadshotCone, "half angle of frontal cone of defender where hits are headshots, disabled if 0")
+	flagHeadshotMultiplier  = pflag.Float32("gamesession.damage.headshot", defaultHeadshotMultiplier, "damage multiplier of headshot, helmets with damage reduction mitigate headshots")
+	flagBackstabCone        = pflag.Float32("gamesession.damage.backstab_cone", defaultBackstabCone, "half angle of rear cone of defender where hits are backstabs, disabled if 0")
+	flagBackstabMultiplier  = pflag.Float32("gamesession.damage.backstab", defaultBackstabMultiplier, "damage multiplier of backstab")
+	flagCritChance          = pflag.Float32("gamesession.damage.crit_chance", defaultCritChance, "chance of critical hit, rolled from loot seed, disabled if 0")
+	flagCritMultiplier      = pflag.Float32("gamesession.damage.crit", defaultCritMultiplier, "damage multiplier of critical hit")
+	flagMinDamage           = pflag.Int32("gamesession.damage.min", defaultMinDamage, "min damage of the hit after armor and block")
 	flagPortToAcceptConns   = pflag.Int("gameserver.port", defaultPortToAcceptConns, "port to expose to clients")
+	flagReplayFilePath      = pflag.String("gamemanager.replay.file", defaultReplayFilePath, "path to record match replay to, disabled if empty")
+	flagReplayKeyframes     = pflag.Int("gamemanager.replay.keyframes", defaultReplayKeyframes, "ticks between game state keyframes in replay")
 
 	flagUsersServiceEnabled            = pflag.Bool("users_service.enabled", defaultEnableUsersServiceUpdate, "make requests to users service")
 	flagUsersServiceAddress            = pflag.String("users_service.address", defaultUsersServiceAddress, "users service address")
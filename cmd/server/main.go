@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"math/rand"
 	"net"
 	"path/filepath"
 	"strings"
@@ -10,11 +11,14 @@ import (
 
 	"github.com/amikhailau/medieval-game-server/pkg/connection"
 	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+	"github.com/amikhailau/medieval-game-server/pkg/mappool"
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 
+	agonesv1 "agones.dev/agones/pkg/apis/agones/v1"
+	coresdk "agones.dev/agones/pkg/sdk"
 	sdk "agones.dev/agones/sdks/go"
 )
 
@@ -29,6 +33,11 @@ func main() {
 	doneC := make(chan error)
 	absPath, _ := filepath.Abs("")
 	mapPath := filepath.Join(absPath, viper.GetString("gamemanager.map.file"))
+	mapId := strings.TrimSuffix(filepath.Base(mapPath), filepath.Ext(mapPath))
+	lootTablesPath := viper.GetString("gamesession.loot.file")
+	if lootTablesPath != "" {
+		lootTablesPath = filepath.Join(absPath, lootTablesPath)
+	}
 
 	agones, err := sdk.NewSDK()
 	if err != nil {
@@ -42,6 +51,28 @@ func main() {
 		log.Fatalf("failed to listen: %v\n", err)
 	}
 
+	stop := make(chan bool)
+	go doHealth(agones, stop)
+
+	// map of the pool is chosen by metadata of allocation, so server becomes ready before game manager is created
+	ready := false
+	if poolDir := viper.GetString("gamemanager.map.pool"); poolDir != "" {
+		pool, err := mappool.LoadPool(filepath.Join(absPath, poolDir))
+		if err != nil {
+			log.Fatalf("failed to load map pool: %v\n", err)
+		}
+		if err = agones.Ready(); err != nil {
+			log.Fatalf("unable to send ready status: %v\n", err)
+		}
+		ready = true
+		entry, err := pool.Select(waitForAllocation(agones), viper.GetInt("gamesession.player.count"), rand.New(rand.NewSource(time.Now().UnixNano())))
+		if err != nil {
+			log.Fatalf("failed to select map: %v\n", err)
+		}
+		mapId, mapPath = entry.Id, pool.Path(entry)
+	}
+	log.Printf("match is played on map %v", mapId)
+
 	gm, err := connection.NewGameManager(&connection.GameManagerConfig{
 		Gscfg: &gamesession.GameSessionConfig{
 			GameStatesSaved:     viper.GetInt("gamesession.states.saved"),
@@ -60,11 +91,57 @@ func main() {
 						KnockbackPower: 2,
 						Range:          7,
 						AttackCone:     0.79,
+						AttackInterval: 0.5,
 					},
 				},
 			},
+			ConsumableSlots:        viper.GetInt("gamesession.player.consumables"),
+			PlayerMaxStamina:       float32(viper.GetFloat64("gamesession.player.stamina")),
+			StaminaRegen:           float32(viper.GetFloat64("gamesession.player.stamina_regen")),
+			AttackStaminaCost:      float32(viper.GetFloat64("gamesession.attack.stamina")),
+			BlockStaminaCost:       float32(viper.GetFloat64("gamesession.block.stamina")),
+			AttackCooldowns:        viper.GetBool("gamesession.attack.cooldowns"),
+			BlockCone:              float32(viper.GetFloat64("gamesession.block.cone")),
+			BlockDamageReduction:   float32(viper.GetFloat64("gamesession.block.reduction")),
+			ParryWindow:            float32(viper.GetFloat64("gamesession.block.parry")),
+			StaggerDuration:        float32(viper.GetFloat64("gamesession.block.stagger")),
+			PlayerMaxSpeed:         float32(viper.GetFloat64("gamesession.player.speed")),
+			PlayerSprintMultiplier: float32(viper.GetFloat64("gamesession.player.sprint")),
+			PlayerAcceleration:     float32(viper.GetFloat64("gamesession.player.acceleration")),
+			PlayerCollision:        viper.GetBool("gamesession.player.collision"),
+			TeamSize:               viper.GetInt("gamesession.team.size"),
+			FriendlyFire:           viper.GetBool("gamesession.team.friendly_fire"),
+			KnockdownHp:            viper.GetInt32("gamesession.team.knockdown_hp"),
+			KnockdownBleed:         float32(viper.GetFloat64("gamesession.team.bleed")),
+			ReviveDuration:         float32(viper.GetFloat64("gamesession.team.revive_duration")),
+			ReviveRange:            float32(viper.GetFloat64("gamesession.team.revive_range")),
+			ReviveHp:               viper.GetInt32("gamesession.team.revive_hp"),
+			GameMode:               viper.GetString("gamesession.mode"),
+			MatchDuration:          float32(viper.GetFloat64("gamesession.deathmatch.duration")),
+			ScoreLimit:             viper.GetInt32("gamesession.deathmatch.score_limit"),
+			RespawnDelay:           float32(viper.GetFloat64("gamesession.deathmatch.respawn")),
+			SpawnProtection:        float32(viper.GetFloat64("gamesession.deathmatch.protection")),
+			InterpolationDelay:     float32(viper.GetFloat64("gamesession.lag.interpolation")),
+			MaxRewind:              float32(viper.GetFloat64("gamesession.lag.max_rewind")),
+			ViewRadius:             float32(viper.GetFloat64("gamesession.view.radius")),
+			ViewOcclusion:          viper.GetBool("gamesession.view.occlusion"),
+			LootTablesFile:         lootTablesPath,
+			LootSeed:               viper.GetInt64("gamesession.loot.seed"),
+			ArmorFormula:           viper.GetString("gamesession.damage.armor"),
+			ArmorCurve:             float32(viper.GetFloat64("gamesession.damage.armor_curve")),
+			HeadshotCone:           float32(viper.GetFloat64("gamesession.damage.headshot_cone")),
+			HeadshotMultiplier:     float32(viper.GetFloat64("gamesession.damage.headshot")),
+			BackstabCone:           float32(viper.GetFloat64("gamesession.damage.backstab_cone")),
+			BackstabMultiplier:     float32(viper.GetFloat64("gamesession.damage.backstab")),
+			CritChance:             float32(viper.GetFloat64("gamesession.damage.crit_chance")),
+			CritMultiplier:         float32(viper.GetFloat64("gamesession.damage.crit")),
+			MinDamage:              viper.GetInt32("gamesession.damage.min"),
 		},
-		MapFile: mapPath,
+		MapId:                  mapId,
+		MapFile:                mapPath,
+		ReplayFile:             viper.GetString("gamemanager.replay.file"),
+		ReplayKeyframeInterval: viper.GetInt("gamemanager.replay.keyframes"),
+		PingInterval:           viper.GetDuration("gamemanager.ping.interval"),
 		Uscfg: &connection.UsersServiceConfig{
 			Enabled:                viper.GetBool("users_service.enabled"),
 			Address:                viper.GetString("users_service.address"),
@@ -95,11 +172,11 @@ func main() {
 		doneC <- s.Serve(lis)
 	}()
 
-	stop := make(chan bool)
-	go doHealth(agones, stop)
-	err = agones.Ready()
-	if err != nil {
-		log.Fatalf("unable to send ready status: %v\n", err)
+	if !ready {
+		err = agones.Ready()
+		if err != nil {
+			log.Fatalf("unable to send ready status: %v\n", err)
+		}
 	}
 
 	fmt.Printf("Server Initialized! Serving on %v port\n", port)
@@ -117,6 +194,34 @@ func main() {
 	}
 }
 
+// waitForAllocation blocks until game server is allocated and returns its labels and annotations,
+// annotations take precedence over labels with the same key
+func waitForAllocation(agones *sdk.SDK) map[string]string {
+	allocated := make(chan *coresdk.GameServer, 1)
+	err := agones.WatchGameServer(func(gs *coresdk.GameServer) {
+		if gs.GetStatus().GetState() != string(agonesv1.GameServerStateAllocated) {
+			return
+		}
+		select {
+		case allocated <- gs:
+		default:
+		}
+	})
+	if err != nil {
+		log.Fatalf("unable to watch game server: %v\n", err)
+	}
+
+	gs := <-allocated
+	metadata := make(map[string]string)
+	for key, value := range gs.GetObjectMeta().GetLabels() {
+		metadata[key] = value
+	}
+	for key, value := range gs.GetObjectMeta().GetAnnotations() {
+		metadata[key] = value
+	}
+	return metadata
+}
+
 func doHealth(sdk *sdk.SDK, stop <-chan bool) {
 	tick := time.Tick(3 * time.Second)
 	for {
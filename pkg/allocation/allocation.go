@@ -51,19 +51,24 @@ func ConnectToAgonesInCluster() (*versioned.Clientset, error) {
 	return agonesClient, nil
 }
 
-func createAgonesGameServerAllocation() *allocationv1.GameServerAllocation {
+func createAgonesGameServerAllocation(annotations map[string]string) *allocationv1.GameServerAllocation {
 	return &allocationv1.GameServerAllocation{
 		Spec: allocationv1.GameServerAllocationSpec{
 			Required: metav1.LabelSelector{
 				MatchLabels: map[string]string{agonesv1.FleetNameLabel: "medieval-game-server-fleet"},
 			},
+			MetaPatch: allocationv1.MetaPatch{
+				Annotations: annotations,
+			},
 		},
 	}
 }
 
-func AllocateGameServer(agonesClient *versioned.Clientset) (*allocationv1.GameServerAllocation, error) {
+// AllocateGameServer allocates game server of the fleet, annotations are added to allocated game server
+// to pass match settings like map
+func AllocateGameServer(agonesClient *versioned.Clientset, annotations map[string]string) (*allocationv1.GameServerAllocation, error) {
 
-	gsa, err := agonesClient.AllocationV1().GameServerAllocations("medieval-game-server").Create(context.Background(), createAgonesGameServerAllocation(), metav1.CreateOptions{})
+	gsa, err := agonesClient.AllocationV1().GameServerAllocations("medieval-game-server").Create(context.Background(), createAgonesGameServerAllocation(annotations), metav1.CreateOptions{})
 	if err != nil {
 		log.Printf("error requesting allocation: %v\n", err)
 		return nil, err
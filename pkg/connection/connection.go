@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
+	"github.com/amikhailau/medieval-game-server/pkg/replay"
 )
 
 const (
@@ -23,10 +25,17 @@ const (
 	AuthorizationHeader = "Token"
 )
 
+// rttSmoothing is the weight of a new round trip time sample in smoothed value
+const rttSmoothing = 0.125
+
 type GameManagerConfig struct {
-	Gscfg   *gamesession.GameSessionConfig
-	MapFile string
-	Uscfg   *UsersServiceConfig
+	Gscfg                  *gamesession.GameSessionConfig
+	MapId                  string
+	MapFile                string
+	Uscfg                  *UsersServiceConfig
+	ReplayFile             string
+	ReplayKeyframeInterval int
+	PingInterval           time.Duration
 }
 
 type ClientConnection struct {
@@ -37,6 +46,7 @@ type ClientConnection struct {
 	userId       string
 	token        string
 	nickname     string
+	rtt          time.Duration
 	sync.RWMutex
 }
 
@@ -74,26 +84,46 @@ func NewGameManager(cfg *GameManagerConfig) (*GameManager, error) {
 
 		gm.gameOngoing = true
 		go gm.BroadcastNotification(&pb.ServerNotification{
-			Type: pb.ServerNotificationType_GAME_STARTED,
+			Type:  pb.ServerNotificationType_GAME_STARTED,
+			MapId: cfg.MapId,
 		})
 
 		for _, client := range gm.clients {
 			gs.SetPlayerInfo(client.nickname, client.userId, client.playerId)
 		}
 
-		prevGameStates := make([]gamesession.PrevGameState, 0, cfg.Gscfg.GameStatesSaved)
-		for i := 0; i < cfg.Gscfg.GameStatesSaved; i++ {
-			prevGameStates = append(prevGameStates, gs.GameState.GetPrevGameState(gm.cfg.Gscfg.PlayerCount, gm.cfg.Gscfg.PlayerRadius))
+		gs.InitPrevGameStates()
+
+		var recorder *replay.Recorder
+		if cfg.ReplayFile != "" {
+			var err error
+			recorder, err = replay.NewRecorder(cfg.ReplayFile, gs, cfg.ReplayKeyframeInterval)
+			if err != nil {
+				log.Printf("unable to record replay: %v\n", err)
+			}
 		}
-		gs.PrevGameStates = prevGameStates
 
 		tickDuration := time.Millisecond * time.Duration(1000.0/float64(gm.cfg.Gscfg.TicksPerSecond))
 		ticker := time.NewTicker(tickDuration)
+		lastPing := time.Now()
 
 		for {
 			<-ticker.C
 			endGame := gs.DoSessionTick()
+			if recorder != nil {
+				if err := recorder.RecordTick(gs, endGame); err != nil {
+					log.Printf("unable to record replay tick: %v\n", err)
+					recorder.Close()
+					recorder = nil
+				}
+			}
 			go gm.BroadcastGameState()
+			if cfg.PingInterval > 0 && time.Since(lastPing) >= cfg.PingInterval {
+				lastPing = time.Now()
+				go gm.BroadcastNotification(&pb.ServerNotification{
+					Type: pb.ServerNotificationType_PING,
+				})
+			}
 
 			moreMessages := true
 			for moreMessages {
@@ -109,6 +139,37 @@ func NewGameManager(cfg *GameManagerConfig) (*GameManager, error) {
 
 			}
 
+			moreMessages = true
+			for moreMessages {
+				select {
+				case rejection := <-gs.AttackRejections:
+					go gm.SendNotification(rejection.PlayerId, &pb.ServerNotification{
+						Type:         pb.ServerNotificationType_ATTACK_REJECTED,
+						Actor:        strconv.Itoa(int(rejection.PlayerId)),
+						CooldownLeft: rejection.CooldownLeft,
+					})
+				default:
+					moreMessages = false
+				}
+
+			}
+
+			hits := make([]*pb.HitEvent, 0)
+			moreMessages = true
+			for moreMessages {
+				select {
+				case hit := <-gs.HitEvents:
+					log.Printf("player %v hit player %v: %v\n", hit.AttackerId, hit.DefenderId, hit)
+					hits = append(hits, hit)
+				default:
+					moreMessages = false
+				}
+
+			}
+			if len(hits) > 0 {
+				go gm.sendHits(hits)
+			}
+
 			moreMessages = true
 			for moreMessages {
 				select {
@@ -129,8 +190,14 @@ func NewGameManager(cfg *GameManagerConfig) (*GameManager, error) {
 		}
 
 		ticker.Stop()
+		if recorder != nil {
+			if err := recorder.Close(); err != nil {
+				log.Printf("unable to save replay: %v\n", err)
+			}
+		}
 		go gm.BroadcastNotification(&pb.ServerNotification{
-			Type: pb.ServerNotificationType_GAME_FINISHED,
+			Type:  pb.ServerNotificationType_GAME_FINISHED,
+			MapId: cfg.MapId,
 		})
 		gm.SendResults()
 		gm.FinishChan <- true
@@ -180,6 +247,7 @@ func (gm *GameManager) Connect(ctx context.Context, req *pb.ConnectRequest) (*pb
 	return &pb.ConnectResponse{
 		Ping:  ping,
 		Token: clientToken,
+		MapId: gm.cfg.MapId,
 	}, nil
 }
 
@@ -233,12 +301,16 @@ func (gm *GameManager) Talk(srv pb.GameManager_TalkServer) error {
 					log.Printf("Player with user id %v has connected\n", client.userId)
 					gm.startChan <- true
 					continue
+				case pb.NotificationType_PONG:
+					gm.updateLatency(client, not.ServerTime)
+					continue
 				}
 			}
 
 			if gm.gameOngoing {
 				if action := req.GetAction(); action != nil {
-					gm.gs.ProcessAction(action, client.playerId)
+					// duplicate and out of order inputs are common on lossy networks, so rejections are not logged
+					gm.gs.QueueSequencedAction(action, client.playerId, req.Sequence)
 				}
 			}
 		}
@@ -265,6 +337,26 @@ func (gm *GameManager) Talk(srv pb.GameManager_TalkServer) error {
 	return nil
 }
 
+// updateLatency measures round trip time by server time of the ping echoed by client
+func (gm *GameManager) updateLatency(client *ClientConnection, pingTime *timestamp.Timestamp) {
+	if pingTime == nil {
+		return
+	}
+	sample := time.Since(pingTime.AsTime())
+	if sample < 0 {
+		return
+	}
+	client.Lock()
+	if client.rtt == 0 {
+		client.rtt = sample
+	} else {
+		client.rtt += time.Duration(rttSmoothing * float64(sample-client.rtt))
+	}
+	rtt := client.rtt
+	client.Unlock()
+	gm.gs.SetPlayerLatency(client.playerId, rtt)
+}
+
 func (gm *GameManager) BroadcastNotification(not *pb.ServerNotification) {
 	serverTime := ptypes.TimestampNow()
 	for _, client := range gm.clients {
@@ -281,19 +373,53 @@ func (gm *GameManager) BroadcastNotification(not *pb.ServerNotification) {
 	}
 }
 
+func (gm *GameManager) SendNotification(playerId int32, not *pb.ServerNotification) {
+	serverTime := ptypes.TimestampNow()
+	for _, client := range gm.clients {
+		if client.playerId != playerId {
+			continue
+		}
+		client.RLock()
+		if client.streamServer != nil {
+			if err := client.streamServer.Send(&pb.ServerResponse{Info: &pb.ServerResponse_Notification{Notification: not}, ServerTime: serverTime}); err != nil {
+				log.Printf("user{id: %v, playerId: %v, nickname: %v} - unable to reach: %v\n", client.userId, client.playerId, client.nickname, err)
+				client.done <- err
+			} else {
+				log.Printf("user{id: %v, playerId: %v, nickname: %v} - received notication: %v\n", client.userId, client.playerId, client.nickname, not.Type)
+			}
+		}
+		client.RUnlock()
+	}
+}
+
+// sendHits notifies only attacker and defender about hit breakdown, so other players do not learn about hidden fights
+func (gm *GameManager) sendHits(hits []*pb.HitEvent) {
+	for _, hit := range hits {
+		not := &pb.ServerNotification{
+			Type:     pb.ServerNotificationType_PLAYER_HIT,
+			Actor:    strconv.Itoa(int(hit.AttackerId)),
+			Receiver: strconv.Itoa(int(hit.DefenderId)),
+			Hit:      hit,
+		}
+		gm.SendNotification(hit.AttackerId, not)
+		if hit.DefenderId != hit.AttackerId {
+			gm.SendNotification(hit.DefenderId, not)
+		}
+	}
+}
+
 func (gm *GameManager) BroadcastGameState() {
 	serverTime := ptypes.TimestampNow()
+	gameStates := make(map[int32]*pb.GameState, len(gm.clients))
 	gm.gs.RLock()
-	newGameState := &pb.GameState{
-		Players:      gm.gs.PrevGameStates[gm.cfg.Gscfg.GameStatesSaved-gm.cfg.Gscfg.GameStatesShiftBack].Players,
-		DroppedItems: gm.gs.PrevGameStates[gm.cfg.Gscfg.GameStatesSaved-gm.cfg.Gscfg.GameStatesShiftBack].Items,
-		PlayersLeft:  int32(gm.gs.PrevGameStates[gm.cfg.Gscfg.GameStatesSaved-gm.cfg.Gscfg.GameStatesShiftBack].PlayersLeft),
+	for _, client := range gm.clients {
+		gameStates[client.playerId] = gm.gs.VisibleStateProto(client.playerId)
 	}
 	gm.gs.RUnlock()
 	for _, client := range gm.clients {
 		client.RLock()
 		if client.streamServer != nil {
-			if err := client.streamServer.Send(&pb.ServerResponse{Info: &pb.ServerResponse_GameState{GameState: newGameState}, ServerTime: serverTime}); err != nil {
+			if err := client.streamServer.Send(&pb.ServerResponse{Info: &pb.ServerResponse_GameState{GameState: gameStates[client.playerId]}, ServerTime: serverTime}); err != nil {
 				log.Printf("user{id: %v, playerId: %v, nickname: %v} - unable to reach: %v\n", client.userId, client.playerId, client.nickname, err)
 				client.done <- err
 			} else {
@@ -26,6 +26,7 @@ func TestConnect(t *testing.T) {
 		startChan:   make(chan bool, 2),
 		clients:     make(map[string]*ClientConnection),
 		clientCount: 0,
+		cfg:         &GameManagerConfig{MapId: "testmap"},
 	}
 
 	okTime, _ := ptypes.TimestampProto(time.Now())
@@ -81,6 +82,10 @@ func TestConnect(t *testing.T) {
 				if resp.Ping < 0 {
 					t.Errorf("expected ping to be positive, got: %v", resp.Ping)
 				}
+
+				if resp.MapId != "testmap" {
+					t.Errorf("expected map id in response, got: %v", resp.MapId)
+				}
 			}
 		})
 	}
@@ -255,6 +260,7 @@ func TestGameplayLoop(t *testing.T) {
 				},
 			},
 		},
+		MapId:   "testmap",
 		MapFile: mapPath,
 		Uscfg: &UsersServiceConfig{
 			Enabled:                false,
@@ -22,10 +22,11 @@ type BackoffConfig struct {
 }
 
 type UpdateUserStatsRequest struct {
-	AddGames int32 `json:"add_games,omitempty"`
-	AddWins  int32 `json:"add_wins,omitempty"`
-	AddTop5  int32 `json:"add_top5,omitempty"`
-	AddKills int32 `json:"add_kills,omitempty"`
+	AddGames int32  `json:"add_games,omitempty"`
+	AddWins  int32  `json:"add_wins,omitempty"`
+	AddTop5  int32  `json:"add_top5,omitempty"`
+	AddKills int32  `json:"add_kills,omitempty"`
+	MapId    string `json:"map_id,omitempty"`
 }
 
 type GrantCurrenciesRequest struct {
@@ -50,7 +51,7 @@ func (gm *GameManager) SendResults() {
 	if gm.cfg.Uscfg.Enabled {
 		for _, client := range gm.clients {
 			player := gm.gs.GameState.Players[int(client.playerId)]
-			err := gm.sendUpdateStatsRequest(client.nickname, player.Position == 1, player.Position <= 5, player.PlayerInfo.Stats.Kills)
+			err := gm.sendUpdateStatsRequest(client.nickname, player.Placement() == 1, player.Placement() <= 5, player.PlayerInfo.Stats.Kills)
 			if err != nil {
 				log.Printf("Unable to update user \"%v\": %v", client.nickname, err)
 			}
@@ -66,6 +67,7 @@ func (gm *GameManager) sendUpdateStatsRequest(nickname string, won, top5 bool, k
 	req := UpdateUserStatsRequest{
 		AddGames: 1,
 		AddKills: kills,
+		MapId:    gm.cfg.MapId,
 	}
 	if won {
 		req.AddWins = 1
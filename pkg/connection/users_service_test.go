@@ -95,6 +95,7 @@ func TestSendResults(t *testing.T) {
 	testGM := &GameManager{
 		clients: testClients,
 		cfg: &GameManagerConfig{
+			MapId: "testmap",
 			Uscfg: &UsersServiceConfig{
 				Enabled:                true,
 				Address:                usersServiceAddress,
@@ -158,10 +159,13 @@ func mockUpdateStats(usersServiceAddress, usersServicePutEndpoint, usersServiceT
 			if req.AddKills != p.PlayerInfo.Stats.Kills {
 				return httpmock.NewStringResponse(400, "Request: Kills amount."), nil
 			}
-			if req.AddWins != 1 && p.Position == 1 {
+			if req.MapId != "testmap" {
+				return httpmock.NewStringResponse(400, "Request: Map id."), nil
+			}
+			if req.AddWins != 1 && p.Placement() == 1 {
 				return httpmock.NewStringResponse(400, "Request: Wins amount."), nil
 			}
-			if req.AddTop5 != 1 && p.Position <= 5 {
+			if req.AddTop5 != 1 && p.Placement() <= 5 {
 				return httpmock.NewStringResponse(400, "Request: Top5 amount."), nil
 			}
 			return httpmock.NewJsonResponse(200, nil)
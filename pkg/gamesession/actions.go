@@ -7,10 +7,25 @@ import (
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
 )
 
+// ProcessAction applies action immediately bypassing input queue, clients' actions should go through QueueAction
 func (g *GameSession) ProcessAction(action *pb.Action, playerId int32) {
-	g.RLock()
-	defer g.RUnlock()
-	if player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(playerId)]; player.Hp <= 0 {
+	g.inputsLock.Lock()
+	rewindTicks := g.rewindTicks[playerId]
+	g.inputsLock.Unlock()
+	g.Lock()
+	defer g.Unlock()
+	g.applyInput(&pb.ReplayInput{PlayerId: playerId, Action: action, RewindTicks: rewindTicks})
+}
+
+func (g *GameSession) applyAction(action *pb.Action, playerId int32) {
+	player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(playerId)]
+	if player.Hp <= 0 {
+		return
+	}
+	if player.KnockedDown && action.GetMove() == nil {
+		return
+	}
+	if g.GameState.Players[int(playerId)].stunned() {
 		return
 	}
 
@@ -34,67 +49,73 @@ func (g *GameSession) ProcessAction(action *pb.Action, playerId int32) {
 		return
 	}
 
+	if useItemAction := action.GetUseItem(); useItemAction != nil {
+		g.processUseItemAction(useItemAction, playerId)
+		return
+	}
+
+	if blockAction := action.GetBlock(); blockAction != nil {
+		g.processBlockAction(blockAction, playerId)
+		return
+	}
+
+	if interactAction := action.GetInteract(); interactAction != nil {
+		g.processInteractAction(interactAction, playerId)
+		return
+	}
+
+	if reviveAction := action.GetRevive(); reviveAction != nil {
+		g.processReviveAction(reviveAction, playerId)
+		return
+	}
+
 }
 
 func (g *GameSession) processMoveAction(moveAction *pb.MovementAction, playerId int32) {
 	player := g.GameState.Players[int(playerId)]
 	minGotYou := player.PlayerInfo.Position.X - g.cfg.PlayerRadius
 	maxGotYou := player.PlayerInfo.Position.X + g.cfg.PlayerRadius
-	player.Lock()
-	//Logic to verify speed?
-	if moveAction.Shift != nil {
-		player.PlayerInfo.Position.X += moveAction.Shift.X
+	var shift *pb.Vector
+	if moveAction.Direction != nil {
+		setMovementIntent(player, moveAction)
+	} else if moveAction.Shift != nil {
+		player.intentMovement = false
+		player.PlayerInfo.Velocity = nil
+		shift = g.clampShift(player, moveAction.Shift)
+		player.PlayerInfo.Position.X += shift.X
 		if player.PlayerInfo.Position.X > g.mapBorderX {
 			player.PlayerInfo.Position.X = g.mapBorderX
+		} else if player.PlayerInfo.Position.X < 0 {
+			player.PlayerInfo.Position.X = 0
 		}
-		player.PlayerInfo.Position.Y += moveAction.Shift.Y
+		player.PlayerInfo.Position.Y += shift.Y
 		if player.PlayerInfo.Position.Y > g.mapBorderY {
 			player.PlayerInfo.Position.Y = g.mapBorderY
+		} else if player.PlayerInfo.Position.Y < 0 {
+			player.PlayerInfo.Position.Y = 0
 		}
 	}
 	player.PlayerInfo.Angle += moveAction.Angle
 	if player.PlayerInfo.Angle > 2*math.Pi {
 		player.PlayerInfo.Angle -= 2 * math.Pi
 	}
-	player.Unlock()
 
-	if moveAction.Shift != nil {
+	if shift != nil {
 		playerBody := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, []float64{
-			float64(maxGotYou), float64(player.PlayerInfo.Position.Y - moveAction.Shift.Y),
-			float64(minGotYou), float64(player.PlayerInfo.Position.Y - moveAction.Shift.Y),
-			float64(minGotYou + moveAction.Shift.X), float64(player.PlayerInfo.Position.Y),
-			float64(maxGotYou + moveAction.Shift.X), float64(player.PlayerInfo.Position.Y),
+			float64(maxGotYou), float64(player.PlayerInfo.Position.Y - shift.Y),
+			float64(minGotYou), float64(player.PlayerInfo.Position.Y - shift.Y),
+			float64(minGotYou + shift.X), float64(player.PlayerInfo.Position.Y),
+			float64(maxGotYou + shift.X), float64(player.PlayerInfo.Position.Y),
 		})
 
-		intervals := make(map[int]bool)
-
-		for _, sEntity := range g.sortedEntities {
-			if sEntity.value > maxGotYou+moveAction.Shift.X {
-				break
-			}
-			if sEntity.value < minGotYou {
-				if sEntity.start {
-					intervals[sEntity.entityId] = true
-				} else {
-					intervals[sEntity.entityId] = false
-				}
-				continue
-			}
-			intervals[sEntity.entityId] = true
-		}
-
-		for entityId, in := range intervals {
-			if !in {
-				continue
-			}
+		minX, minY, maxX, maxY := polygonBounds(playerBody)
+		for _, entityId := range g.entityGrid.QueryAABB(minX, minY, maxX, maxY) {
 			_, info := collision2d.TestPolygonPolygon(playerBody, g.unmovableEntities[entityId])
 			if info.Overlap < 0 {
 				continue
 			}
-			player.Lock()
 			player.PlayerInfo.Position.X = g.PrevGameStates[g.cfg.GameStatesSaved-1].Players[int(player.PlayerInfo.PlayerId)].Position.X
 			player.PlayerInfo.Position.Y = g.PrevGameStates[g.cfg.GameStatesSaved-1].Players[int(player.PlayerInfo.PlayerId)].Position.Y
-			player.Unlock()
 			break
 		}
 	}
@@ -102,52 +123,69 @@ func (g *GameSession) processMoveAction(moveAction *pb.MovementAction, playerId
 }
 
 func (g *GameSession) processAttackAction(attackAction *pb.AttackAction, playerId int32) {
+	if !g.startAttack(playerId) {
+		return
+	}
+	g.resolveAttack(playerId)
+}
+
+// notifyAttack drops the notification when nobody drains the channel, so the tick is never blocked
+func (g *GameSession) notifyAttack(playerId int32) {
+	select {
+	case g.AttackNotifications <- playerId:
+	default:
+	}
+}
+
+func (g *GameSession) resolveAttack(playerId int32) {
 	player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(playerId)]
 	weapon := player.Equipment.Weapon
-	minGotYou := player.Position.X - weapon.GetWeaponChars().GetRange()
-	maxGotYou := player.Position.X + weapon.GetWeaponChars().GetRange()
-	intervals := make(map[int32]bool)
-	for _, sPlayer := range g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].SortedPlayers {
-		if sPlayer.playerId == playerId {
-			continue
-		}
-		if sPlayer.value > maxGotYou {
-			break
-		}
-		if sPlayer.value < minGotYou {
+	if isRangedWeapon(weapon) {
+		g.processRangedAttack(player, playerId)
+		return
+	}
+	playerGrid := g.rewoundState(g.GameState.Players[playerId].rewindTicks).PlayerGrid
+	possiblePlayers := playerGrid.QueryRadius(player.Position.X, player.Position.Y, weapon.GetWeaponChars().GetRange())
+	g.notifyAttack(playerId)
+	for _, possiblePlayer := range possiblePlayers {
+		if int32(possiblePlayer) == playerId {
 			continue
 		}
-		intervals[sPlayer.playerId] = true
-	}
-	g.AttackNotifications <- playerId
-	for possiblePlayer := range intervals {
-		g.processPossibleHit(playerId, possiblePlayer)
+		g.processPossibleHit(playerId, int32(possiblePlayer))
 	}
+	g.processEntityHits(player)
 }
 
 func (g *GameSession) processPickUpAction(pickUpAction *pb.PickUpAction, playerId int32) {
 
-	player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(playerId)]
-	pItemPrev := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Items[int(pickUpAction.ItemId)]
+	prevGameState := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack]
+	player := prevGameState.Players[int(playerId)]
+	if !containsId(prevGameState.ItemGrid.QueryRadius(player.Position.X, player.Position.Y, g.cfg.PlayerPickUpRange), int(pickUpAction.ItemId)) {
+		return
+	}
+	pItemPrev := prevGameState.Items[int(pickUpAction.ItemId)]
 	distance := CalculateDistance(pItemPrev.Position.X, pItemPrev.Position.Y, player.Position.X, player.Position.Y)
 	if distance > g.cfg.PlayerPickUpRange {
 		return
 	}
+	if !g.hasLineOfSight(player.Position.X, player.Position.Y, pItemPrev.Position.X, pItemPrev.Position.Y) {
+		return
+	}
 
 	var itemToDrop *pb.EquipmentItem
 
 	pItem := g.GameState.Items[int(pickUpAction.ItemId)]
-	pItem.Lock()
+	if pItem.ItemInfo.Item.Type == pb.EquipmentItemType_CONSUMABLE && g.cfg.ConsumableSlots <= 0 {
+		return
+	}
 	if pItem.pickedUp {
 		return
 	}
 	pItem.pickedUp = true
 	pItem.ItemInfo.Position.X = -100.0
 	pItem.ItemInfo.Position.Y = -100.0
-	pItem.Unlock()
 
 	playerR := g.GameState.Players[int(playerId)]
-	playerR.Lock()
 	switch pItem.ItemInfo.Item.Type {
 	case pb.EquipmentItemType_ARMOR:
 		itemToDrop = playerR.PlayerInfo.Equipment.Armor
@@ -159,8 +197,16 @@ func (g *GameSession) processPickUpAction(pickUpAction *pb.PickUpAction, playerI
 	case pb.EquipmentItemType_WEAPON:
 		itemToDrop = playerR.PlayerInfo.Equipment.Weapon
 		playerR.PlayerInfo.Equipment.Weapon = pItem.ItemInfo.Item
+	case pb.EquipmentItemType_CONSUMABLE:
+		if len(playerR.PlayerInfo.Equipment.Consumables) >= g.cfg.ConsumableSlots {
+			itemToDrop = playerR.PlayerInfo.Equipment.Consumables[0]
+			if playerR.PlayerInfo.ChannelingItem != nil && playerR.PlayerInfo.ChannelingItem.ItemId == itemToDrop.ItemId {
+				interruptChannel(playerR)
+			}
+			removeConsumable(playerR.PlayerInfo.Equipment, itemToDrop.ItemId)
+		}
+		playerR.PlayerInfo.Equipment.Consumables = append(playerR.PlayerInfo.Equipment.Consumables, pItem.ItemInfo.Item)
 	}
-	playerR.Unlock()
 
 	if itemToDrop != nil && itemToDrop.Rarity != pb.EquipmentItemRarity_DEFAULT {
 		g.dropItem(playerId, itemToDrop.ItemId, false)
@@ -184,6 +230,12 @@ func (g *GameSession) processDropAction(dropAction *pb.DropAction, playerId int3
 			return
 		}
 		itemToDrop = player.Equipment.Weapon
+	case pb.EquipmentItemType_CONSUMABLE:
+		for _, consumable := range player.Equipment.Consumables {
+			if consumable.ItemId == dropAction.ItemId {
+				itemToDrop = consumable
+			}
+		}
 	default:
 		return
 	}
@@ -204,15 +256,11 @@ func (g *GameSession) dropItem(playerId, itemId int32, needToReset bool) {
 	newPosY := player.Position.Y + g.cfg.PlayerDropRange*float32(math.Sin(float64(player.Angle)))
 
 	pItem := g.GameState.Items[int(itemId)]
-	pItem.Lock()
 	pItem.ItemInfo.Position.X = newPosX
 	pItem.ItemInfo.Position.Y = newPosY
 	pItem.pickedUp = false
-	pItem.Unlock()
 
 	playerR := g.GameState.Players[int(playerId)]
-	playerR.Lock()
-	defer playerR.Unlock()
 
 	switch pItem.ItemInfo.Item.Type {
 	case pb.EquipmentItemType_ARMOR:
@@ -233,6 +281,13 @@ func (g *GameSession) dropItem(playerId, itemId int32, needToReset bool) {
 		if needToReset {
 			playerR.PlayerInfo.Equipment.Weapon = g.cfg.DefaultWeapon.Deepcopy()
 		}
+	case pb.EquipmentItemType_CONSUMABLE:
+		if needToReset {
+			if playerR.PlayerInfo.ChannelingItem != nil && playerR.PlayerInfo.ChannelingItem.ItemId == itemId {
+				interruptChannel(playerR)
+			}
+			removeConsumable(playerR.PlayerInfo.Equipment, itemId)
+		}
 	default:
 		return
 	}
@@ -242,11 +297,17 @@ func (g *GameSession) processPossibleHit(attPlayerId, defPlayerId int32) {
 	player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(attPlayerId)]
 	weapon := player.Equipment.Weapon
 	angle := player.Angle
-	pPlayer := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[defPlayerId]
+	pPlayer := g.rewoundState(g.GameState.Players[attPlayerId].rewindTicks).Players[defPlayerId]
 	distance := CalculateDistance(player.Position.X, player.Position.Y, pPlayer.Position.X, pPlayer.Position.Y)
 	if distance > g.cfg.PlayerRadius+weapon.GetWeaponChars().Range {
 		return
 	}
+	if !g.cfg.FriendlyFire && g.areTeammates(attPlayerId, defPlayerId) {
+		return
+	}
+	if !g.hasLineOfSight(player.Position.X, player.Position.Y, pPlayer.Position.X, pPlayer.Position.Y) {
+		return
+	}
 
 	angleBetween := float32(math.Atan(float64((pPlayer.Position.Y - player.Position.Y) / (pPlayer.Position.X - player.Position.X))))
 	angleCone := float32(math.Asin(float64(g.cfg.PlayerRadius / distance)))
@@ -269,28 +330,54 @@ func (g *GameSession) processPossibleHit(attPlayerId, defPlayerId int32) {
 	}
 
 	if SectorCollision(minAngleHits, maxAngleHits, minAngle, maxAngle) {
-		knockbackY := weapon.GetWeaponChars().KnockbackPower * float32(math.Sin(float64(angleBetween)))
-		knockbackX := weapon.GetWeaponChars().KnockbackPower * float32(math.Cos(float64(angleBetween)))
-		attackValue := weapon.GetWeaponChars().AttackPower
-		if pPlayer.Equipment.Armor != nil {
-			attackValue -= pPlayer.Equipment.Armor.GetDamageReduction()
+		attackAngle := float32(math.Atan2(float64(pPlayer.Position.Y-player.Position.Y), float64(pPlayer.Position.X-player.Position.X)))
+		knockbackY := weapon.GetWeaponChars().KnockbackPower * float32(math.Sin(float64(attackAngle)))
+		knockbackX := weapon.GetWeaponChars().KnockbackPower * float32(math.Cos(float64(attackAngle)))
+		if g.hitPlayer(attPlayerId, defPlayerId, weapon.GetWeaponChars().AttackPower, weapon.GetWeaponChars().WallSlamDamage, knockbackX, knockbackY, attackAngle, true) {
+			g.applyOnHitEffects(g.GameState.Players[int(defPlayerId)], attPlayerId, weapon.GetWeaponChars().GetOnHitEffects())
 		}
-		playerToUpdate := g.GameState.Players[int(defPlayerId)]
-		playerToUpdate.Lock()
-		playerToUpdate.PlayerInfo.Hp -= attackValue
-		hpLeft := playerToUpdate.PlayerInfo.Hp
-		playerToUpdate.PlayerInfo.Position.X += knockbackX
-		playerToUpdate.PlayerInfo.Position.Y += knockbackY
-		playerToUpdate.Unlock()
-		playerCurr := g.GameState.Players[int(attPlayerId)]
-		playerCurr.PlayerInfo.Stats.Damage += attackValue
-		if hpLeft <= 0 {
-			g.KillNotifications <- KillInfo{
-				Actor:    player.Nickname,
-				Receiver: pPlayer.Nickname,
-			}
-			g.deadPlayers <- pPlayer.PlayerId
-			playerCurr.PlayerInfo.Stats.Kills += 1
+	}
+}
+
+// hitPlayer returns true if damage reached the defender; parried and fully blocked hits return false
+func (g *GameSession) hitPlayer(attPlayerId, defPlayerId, attackPower, wallSlamDamage int32, knockbackX, knockbackY, attackAngle float32, staggerOnParry bool) bool {
+	playerToUpdate := g.GameState.Players[int(defPlayerId)]
+	if playerToUpdate.PlayerInfo.Hp <= 0 || playerToUpdate.protectionTicks > 0 {
+		return false
+	}
+	multiplier, parried := g.resolveBlock(playerToUpdate, attackAngle)
+	if parried {
+		if staggerOnParry {
+			g.staggerPlayer(attPlayerId)
 		}
+		return false
+	}
+	hit := g.calculateDamage(attPlayerId, playerToUpdate, attackPower, attackAngle, multiplier)
+	knockbackX *= multiplier
+	knockbackY *= multiplier
+	if g.applyKnockback(playerToUpdate, knockbackX, knockbackY) {
+		hit.WallSlamDamage = wallSlamDamage
+		hit.Damage += wallSlamDamage
+	}
+	g.reportHit(hit)
+	playerCurr := g.GameState.Players[int(attPlayerId)]
+	playerCurr.PlayerInfo.Stats.Damage += hit.Damage
+	if g.damagePlayer(playerToUpdate, hit.Damage) {
+		g.deadPlayers <- DeathInfo{PlayerId: defPlayerId, AttackerId: attPlayerId}
+	}
+	return multiplier > 0
+}
+
+// damagePlayer takes hp of player and interrupts channeling, returns true if the damage has finished player.
+// Players under spawn protection take no damage from any source
+func (g *GameSession) damagePlayer(player *SyncPlayer, damage int32) bool {
+	if player.protectionTicks > 0 {
+		return false
+	}
+	hpBefore := player.PlayerInfo.Hp
+	player.PlayerInfo.Hp -= damage
+	if player.PlayerInfo.ChannelingItem != nil {
+		interruptChannel(player)
 	}
+	return hpBefore > 0 && player.PlayerInfo.Hp <= 0
 }
@@ -352,3 +352,85 @@ func checkIfKillNotificationIsPresent(t *testing.T, gs *GameSession, actionId in
 	}
 
 }
+
+func TestProcessUseItemAction(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	player.PlayerInfo.Hp = 50
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 6}}}, 0)
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 7}}}, 0)
+	if len(player.PlayerInfo.Equipment.Consumables) != 2 {
+		t.Fatalf("expected 2 consumables to be picked up, got: %v", len(player.PlayerInfo.Equipment.Consumables))
+	}
+	if !gs.GameState.Items[6].pickedUp || !gs.GameState.Items[7].pickedUp {
+		t.Fatal("consumables not picked up (item side)")
+	}
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_UseItem{UseItem: &pb.UseItemAction{ItemId: 6}}}, 0)
+	if player.PlayerInfo.ChannelingItem == nil || player.PlayerInfo.ChannelingItem.ItemId != 6 {
+		t.Fatal("expected player to channel potion")
+	}
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_UseItem{UseItem: &pb.UseItemAction{ItemId: 7}}}, 0)
+	if player.PlayerInfo.ChannelingItem.ItemId != 6 {
+		t.Fatal("expected channeling not to be replaced by another consumable")
+	}
+
+	for i := 0; i < 29; i++ {
+		gs.DoSessionTick()
+	}
+	if player.PlayerInfo.Hp != 50 || player.PlayerInfo.ChannelingItem == nil {
+		t.Fatalf("expected potion to be still channeling, hp: %v", player.PlayerInfo.Hp)
+	}
+	gs.DoSessionTick()
+	if player.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected potion to heal player to 90 hp, got: %v", player.PlayerInfo.Hp)
+	}
+	if player.PlayerInfo.ChannelingItem != nil || len(player.PlayerInfo.Equipment.Consumables) != 1 {
+		t.Fatal("expected potion to be consumed")
+	}
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_UseItem{UseItem: &pb.UseItemAction{ItemId: 7}}}, 0)
+	for i := 0; i < 10; i++ {
+		gs.DoSessionTick()
+	}
+	gs.GameState.Players[1].PlayerInfo.Position = &pb.Vector{X: 50, Y: 33}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 1)
+	if player.PlayerInfo.Hp != 80 {
+		t.Fatalf("expected player to be hit, hp: %v", player.PlayerInfo.Hp)
+	}
+	if player.PlayerInfo.ChannelingItem != nil {
+		t.Fatal("expected channeling to be interrupted by damage")
+	}
+	if len(player.PlayerInfo.Equipment.Consumables) != 1 {
+		t.Fatal("expected interrupted consumable to stay in inventory")
+	}
+	for i := 0; i < 90; i++ {
+		gs.DoSessionTick()
+	}
+	if player.PlayerInfo.Hp != 80 {
+		t.Fatalf("expected interrupted consumable not to heal, hp: %v", player.PlayerInfo.Hp)
+	}
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Drop{Drop: &pb.DropAction{Slot: pb.EquipmentItemType_CONSUMABLE, ItemId: 7}}}, 0)
+	if len(player.PlayerInfo.Equipment.Consumables) != 0 || gs.GameState.Items[7].pickedUp {
+		t.Fatal("expected bandage to be dropped")
+	}
+}
+
+func TestUndrainedAttackNotifications(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	for i := 0; i <= cap(gs.AttackNotifications); i++ {
+		gs.resolveAttack(0)
+	}
+	if len(gs.AttackNotifications) != cap(gs.AttackNotifications) {
+		t.Fatalf("expected full attack notifications channel, got %v", len(gs.AttackNotifications))
+	}
+}
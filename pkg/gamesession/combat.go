@@ -0,0 +1,72 @@
+package gamesession
+
+import (
+	"math"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func (g *GameSession) processBlockAction(blockAction *pb.BlockAction, playerId int32) {
+	player := g.GameState.Players[int(playerId)]
+	if !blockAction.Active {
+		player.PlayerInfo.Blocking = false
+		return
+	}
+	if player.PlayerInfo.Blocking || player.staggerTicksLeft > 0 || player.PlayerInfo.Stamina <= 0 {
+		return
+	}
+	player.PlayerInfo.Blocking = true
+	player.blockTicks = 0
+}
+
+// spendAttackStamina returns false when player is not able to attack right now
+func (g *GameSession) spendAttackStamina(player *SyncPlayer) bool {
+	if player.staggerTicksLeft > 0 || player.PlayerInfo.Blocking || player.PlayerInfo.Stamina < g.cfg.AttackStaminaCost {
+		return false
+	}
+	player.PlayerInfo.Stamina -= g.cfg.AttackStaminaCost
+	return true
+}
+
+func (g *GameSession) progressStamina(player *SyncPlayer) {
+	if player.staggerTicksLeft > 0 {
+		player.staggerTicksLeft--
+		player.PlayerInfo.StaggerTimeLeft = float32(player.staggerTicksLeft) / float32(g.cfg.TicksPerSecond)
+	}
+	if player.PlayerInfo.Blocking {
+		player.blockTicks++
+		player.PlayerInfo.Stamina -= g.cfg.BlockStaminaCost / float32(g.cfg.TicksPerSecond)
+		if player.PlayerInfo.Stamina <= 0 {
+			player.PlayerInfo.Stamina = 0
+			player.PlayerInfo.Blocking = false
+		}
+		return
+	}
+	player.PlayerInfo.Stamina += g.cfg.StaminaRegen / float32(g.cfg.TicksPerSecond)
+	if player.PlayerInfo.Stamina > g.cfg.PlayerMaxStamina {
+		player.PlayerInfo.Stamina = g.cfg.PlayerMaxStamina
+	}
+}
+
+// resolveBlock returns multiplier for damage and knockback received by defender
+// and whether the attack was parried
+func (g *GameSession) resolveBlock(defender *SyncPlayer, attackAngle float32) (float32, bool) {
+	if !defender.PlayerInfo.Blocking {
+		return 1, false
+	}
+	if math.Abs(float64(AngleDifference(defender.PlayerInfo.Angle, attackAngle+math.Pi))) > float64(g.cfg.BlockCone) {
+		return 1, false
+	}
+	if defender.blockTicks <= int(g.cfg.ParryWindow*float32(g.cfg.TicksPerSecond)) {
+		return 0, true
+	}
+	return 1 - g.cfg.BlockDamageReduction, false
+}
+
+func (g *GameSession) staggerPlayer(playerId int32) {
+	player := g.GameState.Players[int(playerId)]
+	player.staggerTicksLeft = int(g.cfg.StaggerDuration * float32(g.cfg.TicksPerSecond))
+	player.PlayerInfo.StaggerTimeLeft = g.cfg.StaggerDuration
+	player.PlayerInfo.Blocking = false
+	player.windUpTicksLeft = 0
+}
@@ -0,0 +1,116 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestBlockAndParry(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 47}
+	enemy.PlayerInfo.Angle = 3 * math.Pi / 2
+	gs.DoSessionTick()
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Block{Block: &pb.BlockAction{Active: true}}}, 1)
+	if !enemy.PlayerInfo.Blocking {
+		t.Fatal("expected player #1 to start blocking")
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("expected attack to be parried, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if player.PlayerInfo.StaggerTimeLeft != 1 {
+		t.Fatalf("expected player #0 to be staggered after parry, stagger time: %.3f", player.PlayerInfo.StaggerTimeLeft)
+	}
+	if player.PlayerInfo.Stamina != 90 {
+		t.Fatalf("expected attack to drain stamina of player #0, stamina: %.3f", player.PlayerInfo.Stamina)
+	}
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if player.PlayerInfo.Stamina != 90 {
+		t.Fatal("staggered player should not be able to attack")
+	}
+
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	if player.PlayerInfo.StaggerTimeLeft != 0 {
+		t.Fatalf("expected stagger of player #0 to finish, stagger time: %.3f", player.PlayerInfo.StaggerTimeLeft)
+	}
+	if player.PlayerInfo.Stamina <= 90 {
+		t.Fatalf("expected stamina of player #0 to regenerate, stamina: %.3f", player.PlayerInfo.Stamina)
+	}
+	if enemy.PlayerInfo.Stamina >= 100 || !enemy.PlayerInfo.Blocking {
+		t.Fatalf("expected player #1 to keep blocking with stamina drained, stamina: %.3f", enemy.PlayerInfo.Stamina)
+	}
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 97 {
+		t.Fatalf("expected frontal attack to be partially blocked, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if player.PlayerInfo.StaggerTimeLeft != 0 {
+		t.Fatal("blocked attack should not stagger the attacker")
+	}
+
+	enemy.PlayerInfo.Angle = math.Pi / 2
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 87 {
+		t.Fatalf("expected attack from behind not to be blocked, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Block{Block: &pb.BlockAction{Active: false}}}, 1)
+	if enemy.PlayerInfo.Blocking {
+		t.Fatal("expected player #1 to stop blocking")
+	}
+
+	player.PlayerInfo.Stamina = 5
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 87 || player.PlayerInfo.Stamina != 5 {
+		t.Fatal("player without stamina should not be able to attack")
+	}
+
+	enemy.PlayerInfo.Stamina = 0.1
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Block{Block: &pb.BlockAction{Active: true}}}, 1)
+	gs.DoSessionTick()
+	if enemy.PlayerInfo.Blocking || enemy.PlayerInfo.Stamina != 0 {
+		t.Fatalf("expected block to be released when stamina is drained, stamina: %.3f", enemy.PlayerInfo.Stamina)
+	}
+}
+
+func TestBlockedHitReachesDefender(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	enemy := gs.GameState.Players[1]
+	enemy.PlayerInfo.Angle = math.Pi
+
+	for name, tc := range map[string]struct {
+		blocking       bool
+		blockTicks     int
+		blockReduction float32
+		reached        bool
+	}{
+		"unblocked":     {reached: true},
+		"partial block": {blocking: true, blockTicks: 10, blockReduction: 0.75, reached: true},
+		"full block":    {blocking: true, blockTicks: 10, blockReduction: 1},
+		"parry":         {blocking: true, blockReduction: 0.75},
+	} {
+		t.Run(name, func(t *testing.T) {
+			enemy.PlayerInfo.Blocking = tc.blocking
+			enemy.blockTicks = tc.blockTicks
+			gs.cfg.BlockDamageReduction = tc.blockReduction
+			if reached := gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false); reached != tc.reached {
+				t.Fatalf("expected hit reaching defender %v, got %v", tc.reached, reached)
+			}
+		})
+	}
+}
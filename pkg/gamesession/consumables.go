@@ -0,0 +1,67 @@
+package gamesession
+
+import (
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const PlayerBaseHp = 100
+
+func (g *GameSession) processUseItemAction(useItemAction *pb.UseItemAction, playerId int32) {
+	player := g.GameState.Players[int(playerId)]
+	if player.PlayerInfo.ChannelingItem != nil {
+		return
+	}
+	for _, consumable := range player.PlayerInfo.Equipment.Consumables {
+		if consumable.ItemId != useItemAction.ItemId {
+			continue
+		}
+		channelTime := consumable.GetConsumableChars().GetChannelTime()
+		player.PlayerInfo.ChannelingItem = consumable
+		player.PlayerInfo.ChannelTimeLeft = channelTime
+		player.channelTicksLeft = int(channelTime * float32(g.cfg.TicksPerSecond))
+		if player.channelTicksLeft <= 0 {
+			g.finishChannel(player)
+		}
+		return
+	}
+}
+
+func (g *GameSession) progressChannel(player *SyncPlayer) {
+	if player.PlayerInfo.ChannelingItem == nil {
+		return
+	}
+	player.channelTicksLeft--
+	if player.channelTicksLeft > 0 {
+		player.PlayerInfo.ChannelTimeLeft = float32(player.channelTicksLeft) / float32(g.cfg.TicksPerSecond)
+		return
+	}
+	g.finishChannel(player)
+}
+
+func (g *GameSession) finishChannel(player *SyncPlayer) {
+	consumable := player.PlayerInfo.ChannelingItem
+	maxHp := PlayerBaseHp + player.PlayerInfo.Equipment.Helmet.GetHpBuff()
+	if player.PlayerInfo.Hp < maxHp {
+		player.PlayerInfo.Hp += consumable.GetConsumableChars().GetHeal()
+		if player.PlayerInfo.Hp > maxHp {
+			player.PlayerInfo.Hp = maxHp
+		}
+	}
+	removeConsumable(player.PlayerInfo.Equipment, consumable.ItemId)
+	interruptChannel(player)
+}
+
+func interruptChannel(player *SyncPlayer) {
+	player.PlayerInfo.ChannelingItem = nil
+	player.PlayerInfo.ChannelTimeLeft = 0
+	player.channelTicksLeft = 0
+}
+
+func removeConsumable(equipment *pb.PlayerEquipment, itemId int32) {
+	for i, consumable := range equipment.Consumables {
+		if consumable.ItemId == itemId {
+			equipment.Consumables = append(equipment.Consumables[:i], equipment.Consumables[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package gamesession
+
+type AttackRejection struct {
+	PlayerId     int32
+	CooldownLeft float32
+}
+
+// startAttack returns true when attack should be resolved right away,
+// attacks of weapons with wind up are resolved by session tick. Attack interval
+// and wind up of weapons are ignored unless attack cooldowns are enabled
+func (g *GameSession) startAttack(playerId int32) bool {
+	player := g.GameState.Players[int(playerId)]
+	if player.windUpTicksLeft > 0 {
+		g.rejectAttack(playerId, player.windUpTicksLeft)
+		return false
+	}
+	if g.currentTick < player.nextAttackTick {
+		g.rejectAttack(playerId, player.nextAttackTick-g.currentTick)
+		return false
+	}
+	weapon := player.PlayerInfo.Equipment.Weapon
+	if isRangedWeapon(weapon) && weapon.GetWeaponChars().GetAmmo() <= 0 {
+		return false
+	}
+	if !g.spendAttackStamina(player) {
+		return false
+	}
+	// attacking gives up spawn protection
+	player.protectionTicks = 0
+	player.PlayerInfo.SpawnProtectionLeft = 0
+	if !g.cfg.AttackCooldowns {
+		return true
+	}
+	weaponChars := weapon.GetWeaponChars()
+	player.nextAttackTick = g.currentTick + int(weaponChars.GetAttackInterval()*float32(g.cfg.TicksPerSecond))
+	player.windUpTicksLeft = int(weaponChars.GetWindUp() * float32(g.cfg.TicksPerSecond))
+	return player.windUpTicksLeft <= 0
+}
+
+// progressWindUp returns true when wind up of player's attack has finished
+func (g *GameSession) progressWindUp(player *SyncPlayer) bool {
+	if player.windUpTicksLeft <= 0 {
+		return false
+	}
+	player.windUpTicksLeft--
+	return player.windUpTicksLeft == 0
+}
+
+func (g *GameSession) rejectAttack(playerId int32, ticksLeft int) {
+	select {
+	case g.AttackRejections <- AttackRejection{PlayerId: playerId, CooldownLeft: float32(ticksLeft) / float32(g.cfg.TicksPerSecond)}:
+	default:
+	}
+}
@@ -0,0 +1,81 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestAttackCooldown(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.AttackCooldowns = true
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	weaponChars := player.PlayerInfo.Equipment.Weapon.GetWeaponChars()
+	weaponChars.AttackInterval = 0.5
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 47}
+	gs.DoSessionTick()
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected first attack to hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected early attack to be rejected, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	checkIfAttackRejectionIsPresent(t, gs, 0.5)
+
+	for i := 0; i < 14; i++ {
+		gs.DoSessionTick()
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected early attack to be rejected, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	checkIfAttackRejectionIsPresent(t, gs, 1.0/30)
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 80 {
+		t.Fatalf("expected attack after cooldown to hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	weaponChars.WindUp = 0.1
+	for i := 0; i < 15; i++ {
+		gs.DoSessionTick()
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 80 {
+		t.Fatalf("attack should not be resolved before wind up finishes, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	checkIfAttackRejectionIsPresent(t, gs, 0.1)
+	for i := 0; i < 2; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.Hp != 80 {
+		t.Fatalf("attack should not be resolved before wind up finishes, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	gs.DoSessionTick()
+	if enemy.PlayerInfo.Hp != 70 {
+		t.Fatalf("expected attack to be resolved after wind up, hp: %v", enemy.PlayerInfo.Hp)
+	}
+}
+
+func checkIfAttackRejectionIsPresent(t *testing.T, gs *GameSession, cooldownLeft float32) {
+	select {
+	case rejection := <-gs.AttackRejections:
+		if rejection.PlayerId != 0 {
+			t.Fatalf("expected rejection for player #0, got: %v", rejection.PlayerId)
+		}
+		if math.Abs(float64(rejection.CooldownLeft-cooldownLeft)) > 0.001 {
+			t.Fatalf("expected cooldown left %.3f, got: %.3f", cooldownLeft, rejection.CooldownLeft)
+		}
+	default:
+		t.Fatal("expected attack rejection to be created")
+	}
+}
@@ -0,0 +1,95 @@
+package gamesession
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const (
+	FlatArmor    = "flat"
+	PercentArmor = "percent"
+)
+
+// ArmorFormula returns part of damage negated by armor of defender
+type ArmorFormula func(damage float32, armor int32) float32
+
+func NewArmorFormula(name string, curve float32) (ArmorFormula, error) {
+	switch name {
+	case "", FlatArmor:
+		return flatArmor, nil
+	case PercentArmor:
+		if curve <= 0 {
+			return nil, fmt.Errorf("armor curve should be positive for %v armor", PercentArmor)
+		}
+		return func(damage float32, armor int32) float32 {
+			if armor <= 0 {
+				return 0
+			}
+			return damage * float32(armor) / (float32(armor) + curve)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown armor formula: %v", name)
+}
+
+func flatArmor(damage float32, armor int32) float32 {
+	return float32(armor)
+}
+
+// calculateDamage runs attack power through critical hit, hit zone, armor and block multipliers,
+// helmet adds its damage reduction to armor against head hits,
+// damage of the hit is never less than min damage, so armor does not heal defender
+func (g *GameSession) calculateDamage(attPlayerId int32, defender *SyncPlayer, attackPower int32, attackAngle, blockMultiplier float32) *pb.HitEvent {
+	hit := &pb.HitEvent{
+		AttackerId:      attPlayerId,
+		DefenderId:      defender.PlayerInfo.PlayerId,
+		AttackPower:     attackPower,
+		CritMultiplier:  1,
+		Zone:            g.hitZone(defender, attackAngle),
+		ZoneMultiplier:  1,
+		Armor:           defender.PlayerInfo.Equipment.GetArmor().GetDamageReduction(),
+		BlockMultiplier: blockMultiplier,
+	}
+	if g.cfg.CritChance > 0 && g.damageRand.Float32() < g.cfg.CritChance {
+		hit.CritMultiplier = g.cfg.CritMultiplier
+	}
+	switch hit.Zone {
+	case pb.HitZone_HEAD:
+		hit.ZoneMultiplier = g.cfg.HeadshotMultiplier
+		hit.Armor += defender.PlayerInfo.Equipment.GetHelmet().GetDamageReduction()
+	case pb.HitZone_BACK:
+		hit.ZoneMultiplier = g.cfg.BackstabMultiplier
+	}
+
+	damage := float32(attackPower) * hit.CritMultiplier * hit.ZoneMultiplier
+	hit.ArmorMitigated = g.armorFormula(damage, hit.Armor)
+	damage = (damage - hit.ArmorMitigated) * blockMultiplier
+	hit.Damage = int32(math.Round(float64(damage)))
+	if hit.Damage < g.cfg.MinDamage {
+		hit.Damage = g.cfg.MinDamage
+	}
+	if hit.Damage < 0 {
+		hit.Damage = 0
+	}
+	return hit
+}
+
+// hitZone returns head if defender faces the attack and back if the attack comes from behind
+func (g *GameSession) hitZone(defender *SyncPlayer, attackAngle float32) pb.HitZone {
+	diff := math.Abs(float64(AngleDifference(defender.PlayerInfo.Angle, attackAngle)))
+	switch {
+	case g.cfg.BackstabCone > 0 && diff <= float64(g.cfg.BackstabCone):
+		return pb.HitZone_BACK
+	case g.cfg.HeadshotCone > 0 && diff >= math.Pi-float64(g.cfg.HeadshotCone):
+		return pb.HitZone_HEAD
+	}
+	return pb.HitZone_BODY
+}
+
+func (g *GameSession) reportHit(hit *pb.HitEvent) {
+	select {
+	case g.HitEvents <- hit:
+	default:
+	}
+}
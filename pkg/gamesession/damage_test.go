@@ -0,0 +1,115 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestArmorMitigation(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	enemy := gs.GameState.Players[3]
+	hpBefore := enemy.PlayerInfo.Hp
+
+	gs.hitPlayer(0, 3, 10, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != hpBefore {
+		t.Fatalf("expected armor to negate the hit without healing player #3, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	gs.cfg.MinDamage = 1
+	gs.hitPlayer(0, 3, 10, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != hpBefore-1 {
+		t.Fatalf("expected min damage to be dealt to player #3, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	gs.armorFormula, err = NewArmorFormula(PercentArmor, 15)
+	if err != nil {
+		t.Fatalf("unable to create armor formula: %v", err)
+	}
+	gs.hitPlayer(0, 3, 20, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != hpBefore-11 {
+		t.Fatalf("expected armor to negate half of damage, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if _, err = NewArmorFormula(PercentArmor, 0); err == nil {
+		t.Fatal("expected error for percent armor without curve")
+	}
+	if _, err = NewArmorFormula("quadratic", 10); err == nil {
+		t.Fatal("expected error for unknown armor formula")
+	}
+}
+
+func TestHitZonesAndCrits(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.HeadshotCone = 0.5
+	gs.cfg.HeadshotMultiplier = 1.5
+	gs.cfg.BackstabCone = 0.5
+	gs.cfg.BackstabMultiplier = 2
+	gs.cfg.CritMultiplier = 2
+	enemy := gs.GameState.Players[1]
+
+	for name, tc := range map[string]struct {
+		angle      float32
+		critChance float32
+		zone       pb.HitZone
+		damage     int32
+	}{
+		"backstab": {angle: 0, zone: pb.HitZone_BACK, damage: 20},
+		"headshot": {angle: math.Pi, zone: pb.HitZone_HEAD, damage: 15},
+		"body":     {angle: math.Pi / 2, zone: pb.HitZone_BODY, damage: 10},
+		"crit":     {angle: math.Pi / 2, critChance: 1, zone: pb.HitZone_BODY, damage: 20},
+	} {
+		t.Run(name, func(t *testing.T) {
+			enemy.PlayerInfo.Angle = tc.angle
+			gs.cfg.CritChance = tc.critChance
+			hpBefore := enemy.PlayerInfo.Hp
+			gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false)
+			if hpBefore-enemy.PlayerInfo.Hp != tc.damage {
+				t.Fatalf("expected %v damage, got: %v", tc.damage, hpBefore-enemy.PlayerInfo.Hp)
+			}
+			hit := <-gs.HitEvents
+			if hit.Zone != tc.zone || hit.Damage != tc.damage || hit.AttackerId != 0 || hit.DefenderId != 1 {
+				t.Fatalf("unexpected hit breakdown: %v", hit)
+			}
+		})
+	}
+}
+
+func TestHelmetMitigation(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.HeadshotCone = 0.5
+	gs.cfg.HeadshotMultiplier = 1.5
+	enemy := gs.GameState.Players[1]
+	enemy.PlayerInfo.Equipment.Helmet = &pb.EquipmentItem{
+		Type:            pb.EquipmentItemType_HELMET,
+		Characteristics: &pb.EquipmentItem_DamageReduction{DamageReduction: 5},
+	}
+
+	for name, tc := range map[string]struct {
+		angle  float32
+		zone   pb.HitZone
+		armor  int32
+		damage int32
+	}{
+		"headshot": {angle: math.Pi, zone: pb.HitZone_HEAD, armor: 5, damage: 10},
+		"body":     {angle: math.Pi / 2, zone: pb.HitZone_BODY, damage: 10},
+	} {
+		t.Run(name, func(t *testing.T) {
+			enemy.PlayerInfo.Angle = tc.angle
+			hpBefore := enemy.PlayerInfo.Hp
+			gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false)
+			hit := <-gs.HitEvents
+			if hit.Zone != tc.zone || hit.Armor != tc.armor || hpBefore-enemy.PlayerInfo.Hp != tc.damage {
+				t.Fatalf("expected %v hit with armor %v and %v damage, got: %v", tc.zone, tc.armor, tc.damage, hit)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package gamesession
+
+import (
+	"math"
+	"sort"
+)
+
+// Deathmatch is free for all mode where players respawn until score or time limit is reached
+type Deathmatch struct{}
+
+func (m *Deathmatch) PlayerDied(g *GameSession, death DeathInfo) {
+	player := g.GameState.Players[int(death.PlayerId)]
+	if player.respawnTicksLeft > 0 {
+		return
+	}
+	g.notifyKill(KillInfo{
+		Actor:    g.creditKill(death.AttackerId),
+		Receiver: player.PlayerInfo.Nickname,
+	})
+	player.PlayerInfo.Stats.Deaths += 1
+
+	if player.PlayerInfo.ChannelingItem != nil {
+		interruptChannel(player)
+	}
+	player.PlayerInfo.Hp = 0
+	player.PlayerInfo.Blocking = false
+	player.PlayerInfo.Velocity = nil
+	player.windUpTicksLeft = 0
+	player.staggerTicksLeft = 0
+	player.intentMovement = false
+	clearStatusEffects(player)
+	player.respawnTicksLeft = int(g.cfg.RespawnDelay * float32(g.cfg.TicksPerSecond))
+	if player.respawnTicksLeft < 1 {
+		player.respawnTicksLeft = 1
+	}
+	player.PlayerInfo.RespawnTimeLeft = float32(player.respawnTicksLeft) / float32(g.cfg.TicksPerSecond)
+}
+
+func (m *Deathmatch) Tick(g *GameSession) bool {
+	for _, player := range g.GameState.Players {
+		if player.respawnTicksLeft > 0 {
+			player.respawnTicksLeft--
+			player.PlayerInfo.RespawnTimeLeft = float32(player.respawnTicksLeft) / float32(g.cfg.TicksPerSecond)
+			if player.respawnTicksLeft == 0 {
+				m.respawnPlayer(g, player)
+			}
+			continue
+		}
+		if player.protectionTicks > 0 {
+			player.protectionTicks--
+			player.PlayerInfo.SpawnProtectionLeft = float32(player.protectionTicks) / float32(g.cfg.TicksPerSecond)
+		}
+	}
+
+	if g.cfg.ScoreLimit > 0 {
+		for _, player := range g.GameState.Players {
+			if m.Score(player) >= g.cfg.ScoreLimit {
+				return false
+			}
+		}
+	}
+	if g.cfg.MatchDuration > 0 {
+		ticksLeft := int(g.cfg.MatchDuration*float32(g.cfg.TicksPerSecond)) - g.currentTick
+		if ticksLeft < 0 {
+			ticksLeft = 0
+		}
+		g.GameState.TimeLeft = float32(ticksLeft) / float32(g.cfg.TicksPerSecond)
+		if ticksLeft == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *Deathmatch) Score(player *SyncPlayer) int32 {
+	return player.PlayerInfo.Stats.Kills
+}
+
+// FinishMatch places players by score, ties are broken by fewer deaths
+func (m *Deathmatch) FinishMatch(g *GameSession) {
+	ranking := make([]*SyncPlayer, len(g.GameState.Players))
+	copy(ranking, g.GameState.Players)
+	sort.SliceStable(ranking, func(i, j int) bool {
+		scoreI, scoreJ := m.Score(ranking[i]), m.Score(ranking[j])
+		if scoreI != scoreJ {
+			return scoreI > scoreJ
+		}
+		return ranking[i].PlayerInfo.Stats.Deaths < ranking[j].PlayerInfo.Stats.Deaths
+	})
+	for i, player := range ranking {
+		player.TeamPosition = i + 1
+	}
+}
+
+// respawnPlayer puts player on the spawn farthest from alive players
+func (m *Deathmatch) respawnPlayer(g *GameSession, player *SyncPlayer) {
+	spawns := g.MapDesc.PlayerSpawns
+	bestDistance := float32(-1)
+	for i := 0; i+1 < len(spawns); i += 2 {
+		closest := float32(math.MaxFloat32)
+		for _, other := range g.GameState.Players {
+			if other == player || other.PlayerInfo.Hp <= 0 {
+				continue
+			}
+			distance := CalculateDistance(spawns[i], spawns[i+1], other.PlayerInfo.Position.X, other.PlayerInfo.Position.Y)
+			if distance < closest {
+				closest = distance
+			}
+		}
+		if closest > bestDistance {
+			bestDistance = closest
+			player.PlayerInfo.Position.X = spawns[i]
+			player.PlayerInfo.Position.Y = spawns[i+1]
+		}
+	}
+
+	player.PlayerInfo.Hp = PlayerBaseHp + player.PlayerInfo.Equipment.Helmet.GetHpBuff()
+	player.PlayerInfo.Stamina = g.cfg.PlayerMaxStamina
+	player.PlayerInfo.RespawnTimeLeft = 0
+	player.zoneDamage = 0
+	player.protectionTicks = int(g.cfg.SpawnProtection * float32(g.cfg.TicksPerSecond))
+	player.PlayerInfo.SpawnProtectionLeft = float32(player.protectionTicks) / float32(g.cfg.TicksPerSecond)
+}
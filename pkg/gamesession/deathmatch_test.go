@@ -0,0 +1,110 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestDeathmatch(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.mode = &Deathmatch{}
+	gs.cfg.RespawnDelay = 1
+	gs.cfg.SpawnProtection = 1
+	gs.cfg.ScoreLimit = 2
+	gs.MapDesc.PlayerSpawns = []float32{10, 10, 95, 95}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	enemy.PlayerInfo.Hp = 5
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if gs.DoSessionTick() {
+		t.Fatal("match should not be over after first kill")
+	}
+	if enemy.PlayerInfo.Hp != 0 || enemy.Position != 0 || enemy.PlayerInfo.RespawnTimeLeft <= 0 {
+		t.Fatalf("expected player #1 to wait for respawn, hp: %v, respawn time: %.3f", enemy.PlayerInfo.Hp, enemy.PlayerInfo.RespawnTimeLeft)
+	}
+	if player.PlayerInfo.Stats.Kills != 1 || enemy.PlayerInfo.Stats.Deaths != 1 || len(gs.KillNotifications) != 1 {
+		t.Fatalf("kill was not counted, kills: %v, deaths: %v", player.PlayerInfo.Stats.Kills, enemy.PlayerInfo.Stats.Deaths)
+	}
+	gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != 0 {
+		t.Fatalf("dead player should not be hit, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.Hp != 100 || enemy.PlayerInfo.SpawnProtectionLeft <= 0 {
+		t.Fatalf("expected player #1 to respawn with protection, hp: %v, protection: %.3f", enemy.PlayerInfo.Hp, enemy.PlayerInfo.SpawnProtectionLeft)
+	}
+	checkPlayerMovement(t, enemy, 10, 10, 0)
+	gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("protected player should not be hit, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if gs.damagePlayer(enemy, 200) || enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("protected player should not take zone, hazard or effect damage, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	gs.hitPlayer(0, 1, 10, 0, 0, 0, 0, false)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("spawn protection should expire, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.PlayerInfo.Stats.Kills = 2
+	if !gs.DoSessionTick() {
+		t.Fatal("match should be over when score limit is reached")
+	}
+	for i, expected := range []int{1, 4, 2, 3} {
+		if gs.GameState.Players[i].Placement() != expected {
+			t.Fatalf("player #%v expected placement %v, got %v", i, expected, gs.GameState.Players[i].Placement())
+		}
+	}
+}
+
+func TestDeathmatchTimeLimit(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.mode = &Deathmatch{}
+	gs.cfg.MatchDuration = 1
+
+	for i := 0; i < 29; i++ {
+		if gs.DoSessionTick() {
+			t.Fatalf("match ended too early on tick %v", i)
+		}
+	}
+	if gs.GameState.TimeLeft <= 0 || gs.GameState.TimeLeft > 0.1 {
+		t.Fatalf("unexpected time left: %.3f", gs.GameState.TimeLeft)
+	}
+	if !gs.DoSessionTick() {
+		t.Fatal("match should be over when time is up")
+	}
+	if gs.GameState.TimeLeft != 0 {
+		t.Fatalf("unexpected time left: %.3f", gs.GameState.TimeLeft)
+	}
+}
+
+func TestDeathmatchUndrainedKillNotifications(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.mode = &Deathmatch{}
+	for i := 0; i < cap(gs.KillNotifications); i++ {
+		gs.KillNotifications <- KillInfo{}
+	}
+	gs.mode.PlayerDied(gs, DeathInfo{PlayerId: 1, AttackerId: 0})
+	if gs.GameState.Players[0].PlayerInfo.Stats.Kills != 1 || gs.GameState.Players[1].PlayerInfo.Stats.Deaths != 1 {
+		t.Fatalf("kill should be counted when kill feed is full, kills: %v", gs.GameState.Players[0].PlayerInfo.Stats.Kills)
+	}
+}
@@ -0,0 +1,93 @@
+package gamesession
+
+import (
+	"fmt"
+)
+
+const (
+	BattleRoyaleMode = "battle_royale"
+	DeathmatchMode   = "deathmatch"
+)
+
+const (
+	// NoKiller is used as attacker id for deaths which are not caused by other players or hazards
+	NoKiller int32 = -1
+	// HazardKiller is used as attacker id for deaths in map hazards
+	HazardKiller int32 = -2
+)
+
+type DeathInfo struct {
+	PlayerId   int32
+	AttackerId int32
+}
+
+// GameMode defines rules of the match, all hooks are called under session lock
+type GameMode interface {
+	// PlayerDied is called for every player which hp dropped to zero since the last tick
+	PlayerDied(g *GameSession, death DeathInfo)
+	// Tick is called at the start of every session tick, returns false when the match is over
+	Tick(g *GameSession) bool
+	// Score returns points of player used by the mode to rank players
+	Score(player *SyncPlayer) int32
+	// FinishMatch assigns final placements to players
+	FinishMatch(g *GameSession)
+}
+
+func NewGameMode(name string) (GameMode, error) {
+	switch name {
+	case "", BattleRoyaleMode:
+		return &BattleRoyale{}, nil
+	case DeathmatchMode:
+		return &Deathmatch{}, nil
+	}
+	return nil, fmt.Errorf("unknown game mode: %v", name)
+}
+
+// BattleRoyale is last team standing mode with knockdowns
+type BattleRoyale struct{}
+
+func (m *BattleRoyale) PlayerDied(g *GameSession, death DeathInfo) {
+	g.processDeath(death)
+}
+
+func (m *BattleRoyale) Tick(g *GameSession) bool {
+	for _, player := range g.GameState.Players {
+		if player.Position == 0 && player.PlayerInfo.KnockedDown {
+			g.progressKnockdown(player)
+		}
+	}
+	return g.updateTeams()
+}
+
+func (m *BattleRoyale) Score(player *SyncPlayer) int32 {
+	return player.PlayerInfo.Stats.Kills
+}
+
+func (m *BattleRoyale) FinishMatch(g *GameSession) {
+	for _, player := range g.GameState.Players {
+		if player.TeamPosition == 0 {
+			player.TeamPosition = 1
+		}
+	}
+}
+
+// creditKill counts the kill for killer player and returns the name shown in kill feed
+func (g *GameSession) creditKill(killerId int32) string {
+	switch killerId {
+	case NoKiller:
+		return SafeZoneKillActor
+	case HazardKiller:
+		return HazardKillActor
+	}
+	killer := g.GameState.Players[int(killerId)]
+	killer.PlayerInfo.Stats.Kills += 1
+	return killer.PlayerInfo.Nickname
+}
+
+// notifyKill drops the kill feed entry when nobody drains the channel, so the tick is never blocked
+func (g *GameSession) notifyKill(killInfo KillInfo) {
+	select {
+	case g.KillNotifications <- killInfo:
+	default:
+	}
+}
@@ -1,27 +1,69 @@
 package gamesession
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math"
+	"math/rand"
 	"os"
-	"sort"
 	"sync"
+	"time"
 
 	"github.com/Tarliton/collision2d"
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
 )
 
 type GameSessionConfig struct {
-	GameStatesSaved     int
-	GameStatesShiftBack int
-	TicksPerSecond      int
-	PlayerCount         int
-	PlayerPickUpRange   float32
-	PlayerDropRange     float32
-	PlayerRadius        float32
-	DefaultWeapon       *pb.EquipmentItem
+	GameStatesSaved        int
+	GameStatesShiftBack    int
+	TicksPerSecond         int
+	PlayerCount            int
+	PlayerPickUpRange      float32
+	PlayerDropRange        float32
+	PlayerRadius           float32
+	DefaultWeapon          *pb.EquipmentItem `json:"-"`
+	ConsumableSlots        int
+	PlayerMaxStamina       float32
+	StaminaRegen           float32
+	AttackStaminaCost      float32
+	BlockStaminaCost       float32
+	AttackCooldowns        bool
+	BlockCone              float32
+	BlockDamageReduction   float32
+	ParryWindow            float32
+	StaggerDuration        float32
+	PlayerMaxSpeed         float32
+	PlayerSprintMultiplier float32
+	PlayerAcceleration     float32
+	PlayerCollision        bool
+	TeamSize               int
+	FriendlyFire           bool
+	KnockdownHp            int32
+	KnockdownBleed         float32
+	ReviveDuration         float32
+	ReviveRange            float32
+	ReviveHp               int32
+	GameMode               string
+	MatchDuration          float32
+	ScoreLimit             int32
+	RespawnDelay           float32
+	SpawnProtection        float32
+	InterpolationDelay     float32
+	MaxRewind              float32
+	ViewRadius             float32
+	ViewOcclusion          bool
+	LootTablesFile         string
+	LootTables             *LootTablesJSON
+	LootSeed               int64
+	ArmorFormula           string
+	ArmorCurve             float32
+	HeadshotCone           float32
+	HeadshotMultiplier     float32
+	BackstabCone           float32
+	BackstabMultiplier     float32
+	CritChance             float32
+	CritMultiplier         float32
+	MinDamage              int32
 }
 
 type KillInfo struct {
@@ -31,66 +73,115 @@ type KillInfo struct {
 
 type GameSession struct {
 	sync.RWMutex
-	unmovableEntities   []collision2d.Polygon
-	sortedEntities      []SortedEntity
+	unmovableEntities   map[int]collision2d.Polygon
+	entityGrid          *Grid
 	PrevGameStates      []PrevGameState
 	GameState           CurrentGameState
 	AttackNotifications chan int32
 	KillNotifications   chan KillInfo
-	deadPlayers         chan int32
+	AttackRejections    chan AttackRejection
+	HitEvents           chan *pb.HitEvent
+	deadPlayers         chan DeathInfo
+	currentTick         int
 	cfg                 *GameSessionConfig
 	mapBorderX          float32
 	mapBorderY          float32
+	terrain             []Terrain
+	concealments        []collision2d.Polygon
+	hazards             []Hazard
 	MapDesc             MapDescription
-}
-
-type SortedEntity struct {
-	entityId int
-	value    float32
-	start    bool
-}
-
-type SortedPlayer struct {
-	playerId int32
-	value    float32
-	start    bool
+	mapData             []byte
+	lootTables          *LootTablesJSON
+	lootSeed            int64
+	mode                GameMode
+	armorFormula        ArmorFormula
+	damageRand          *rand.Rand
+	nextProjectileId    int32
+	inputsLock          sync.Mutex
+	inputs              *inputQueue
+	appliedInputs       *inputQueue
+	rewindTicks         []int32
+	lastSequences       []uint32
 }
 
 type PrevGameState struct {
-	PlayersLeft   int
-	SortedPlayers []SortedPlayer
-	Players       []*pb.Player
-	Items         []*pb.DroppedEquipmentItem
+	Tick           int
+	PlayersLeft    int
+	TeamsLeft      int
+	TimeLeft       float32
+	PlayerGrid     *Grid
+	ItemGrid       *Grid
+	ProjectileGrid *Grid
+	Players        []*pb.Player
+	Items          []*pb.DroppedEquipmentItem
+	SafeZone       *pb.SafeZone
+	Projectiles    []*pb.Projectile
+	MapEntities    []*pb.MapEntity
 }
 
 type CurrentGameState struct {
 	PlayersLeft int
+	TeamsLeft   int
+	TimeLeft    float32
 	Players     []*SyncPlayer
 	Items       []*SyncItem
+	SafeZone    *SafeZone
+	Projectiles []*SyncProjectile
+	MapEntities []*SyncMapEntity
 }
 
 type SyncPlayer struct {
-	PlayerInfo *pb.Player
-	Position   int
-	sync.Mutex
+	PlayerInfo       *pb.Player
+	Position         int
+	TeamPosition     int
+	zoneDamage       float32
+	channelTicksLeft int
+	blockTicks       int
+	staggerTicksLeft int
+	nextAttackTick   int
+	windUpTicksLeft  int
+	intentMovement   bool
+	moveDirX         float32
+	moveDirY         float32
+	sprint           bool
+	shiftBudget      float32
+	knockedBy        int32
+	reviverId        int32
+	reviveTicksLeft  int
+	bleedDamage      float32
+	respawnTicksLeft int
+	protectionTicks  int
+	rewindTicks      int32
+	hazardDamage     float32
+	statusEffects    []*statusEffect
+	effectImmunity   map[pb.StatusEffectType]int
 }
 
 type SyncItem struct {
 	ItemInfo *pb.DroppedEquipmentItem
 	pickedUp bool
-	sync.Mutex
 }
 
 type PolygonJSON struct {
+	Name     string    `json:"name,omitempty"`
 	Vertexes []float64 `json:"vertexes"`
+	Type     string    `json:"type"`
+	Hp       int32     `json:"hp"`
 }
 
 type MapDescription struct {
-	Polygons     []PolygonJSON `json:"entities"`
-	LootSpawns   []float32     `json:"loot_spots"`
-	PlayerSpawns []float32     `json:"player_spawns"`
-	MapBorderX   float32       `json:"map_border_x"`
-	MapBorderY   float32       `json:"map_border_y"`
+	Polygons       []PolygonJSON     `json:"entities"`
+	LootSpawns     []float32         `json:"loot_spots"`
+	LootSpotTables []string          `json:"loot_spot_tables"`
+	PlayerSpawns   []float32         `json:"player_spawns"`
+	MapBorderX     float32           `json:"map_border_x"`
+	MapBorderY     float32           `json:"map_border_y"`
+	SafeZone       *SafeZoneJSON     `json:"safe_zone"`
+	Terrain        []TerrainJSON     `json:"terrain"`
+	Concealment    []ConcealmentJSON `json:"concealment"`
+	Hazards        []HazardJSON      `json:"hazards"`
+	LootSpotNames  []string          `json:"loot_spot_names"`
+	Metadata       MapMetadataJSON   `json:"metadata"`
 }
 
 func NewGameSession(cfg *GameSessionConfig, mapFilename string) (*GameSession, error) {
@@ -100,38 +191,47 @@ func NewGameSession(cfg *GameSessionConfig, mapFilename string) (*GameSession, e
 	}
 	defer mapFile.Close()
 	bytes, _ := ioutil.ReadAll(mapFile)
-	var mapDesc MapDescription
-	err = json.Unmarshal(bytes, &mapDesc)
+	return NewGameSessionFromMap(cfg, bytes)
+}
+
+// NewGameSessionFromMap creates session from map description in json, loot tables of config take precedence over loot tables file
+func NewGameSessionFromMap(cfg *GameSessionConfig, mapData []byte) (*GameSession, error) {
+	lootSeed := cfg.LootSeed
+	if lootSeed == 0 {
+		lootSeed = time.Now().UnixNano()
+	}
+	mapDesc, err := ParseMap(mapData, cfg.PlayerRadius, lootSeed)
+	if err != nil {
+		return nil, err
+	}
+	if err = ValidateMap(mapDesc, cfg.PlayerRadius); err != nil {
+		return nil, err
+	}
+	mode, err := NewGameMode(cfg.GameMode)
 	if err != nil {
-		return nil, fmt.Errorf("Error unmarshalling map file: %v", err)
+		return nil, err
 	}
-	sortedEntities := make([]SortedEntity, 0, len(mapDesc.Polygons))
-	unmovableEntities := make([]collision2d.Polygon, 0, len(mapDesc.Polygons))
-	for i, polygon := range mapDesc.Polygons {
-		newEntity := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, polygon.Vertexes)
-		box := newEntity.GetAABB()
-		sortedEntities = append(sortedEntities, SortedEntity{entityId: i, value: float32(box.Points[0].X), start: true},
-			SortedEntity{entityId: i, value: float32(box.Points[2].X), start: true})
-		unmovableEntities = append(unmovableEntities, newEntity)
+	armorFormula, err := NewArmorFormula(cfg.ArmorFormula, cfg.ArmorCurve)
+	if err != nil {
+		return nil, err
+	}
+	unmovableEntities, mapEntities, err := NewMapEntities(mapDesc.Polygons)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating map entities: %v", err)
 	}
-	sort.SliceStable(sortedEntities, func(i, j int) bool { return sortedEntities[i].value < sortedEntities[j].value })
-	//ITEM GENERATION?! - loot spots from mapDesc?
-	amountOfItemsToSpawn := int(float64(len(mapDesc.LootSpawns)) / 2.0)
-	items := make([]*SyncItem, 0, amountOfItemsToSpawn)
-	for i := 0; i < amountOfItemsToSpawn; i++ {
-		weaponChars := &pb.WeaponCharacteristics{
-			AttackPower:    15,
-			Range:          10,
-			AttackCone:     math.Pi / 6,
-			KnockbackPower: 3,
+	lootTables := cfg.LootTables
+	if lootTables == nil {
+		lootTables = DefaultLootTables()
+	}
+	if cfg.LootTables == nil && cfg.LootTablesFile != "" {
+		lootTables, err = LoadLootTables(cfg.LootTablesFile)
+		if err != nil {
+			return nil, err
 		}
-		items = append(items, &SyncItem{ItemInfo: &pb.DroppedEquipmentItem{
-			Item: &pb.EquipmentItem{
-				Type:            pb.EquipmentItemType_WEAPON,
-				Rarity:          pb.EquipmentItemRarity_COMMON,
-				Characteristics: &pb.EquipmentItem_WeaponChars{WeaponChars: weaponChars}},
-			Position: &pb.Vector{X: mapDesc.LootSpawns[i*2], Y: mapDesc.LootSpawns[i*2+1]},
-		}})
+	}
+	items, err := NewLootGenerator(lootTables, lootSeed).SpawnItems(mapDesc)
+	if err != nil {
+		return nil, fmt.Errorf("Error spawning loot: %v", err)
 	}
 	if cfg.PlayerCount > int(float64(len(mapDesc.PlayerSpawns))/2.0) {
 		return nil, fmt.Errorf("there should be enough spawns for players")
@@ -140,11 +240,13 @@ func NewGameSession(cfg *GameSessionConfig, mapFilename string) (*GameSession, e
 	for i := 0; i < cfg.PlayerCount; i++ {
 		player := &SyncPlayer{
 			PlayerInfo: &pb.Player{
-				Hp:        100,
+				Hp:        PlayerBaseHp,
+				Stamina:   cfg.PlayerMaxStamina,
 				Equipment: &pb.PlayerEquipment{Weapon: cfg.DefaultWeapon.Deepcopy()},
 				Position:  &pb.Vector{X: mapDesc.PlayerSpawns[i*2], Y: mapDesc.PlayerSpawns[i*2+1]},
 				Angle:     math.Pi / 2,
 				PlayerId:  int32(i),
+				TeamId:    teamId(i, cfg.TeamSize),
 				Stats:     &pb.PlayerStats{},
 			},
 			Position: 0,
@@ -152,16 +254,49 @@ func NewGameSession(cfg *GameSessionConfig, mapFilename string) (*GameSession, e
 		players = append(players, player)
 	}
 
+	var safeZone *SafeZone
+	if mapDesc.SafeZone != nil {
+		safeZone, err = NewSafeZone(mapDesc.SafeZone, cfg.TicksPerSecond)
+		if err != nil {
+			return nil, fmt.Errorf("Error creating safe zone: %v", err)
+		}
+	}
+
+	gameState := CurrentGameState{
+		Items:       items,
+		Players:     players,
+		PlayersLeft: cfg.PlayerCount,
+		TeamsLeft:   teamCount(cfg.PlayerCount, cfg.TeamSize),
+		TimeLeft:    cfg.MatchDuration,
+		SafeZone:    safeZone,
+		MapEntities: mapEntities,
+	}
 	gameSession := &GameSession{
-		GameState:           CurrentGameState{Items: items, Players: players, PlayersLeft: cfg.PlayerCount},
+		GameState:           gameState,
 		cfg:                 cfg,
 		unmovableEntities:   unmovableEntities,
-		sortedEntities:      sortedEntities,
+		entityGrid:          NewEntityGrid(unmovableEntities, cfg.PlayerRadius),
 		mapBorderX:          mapDesc.MapBorderX,
 		mapBorderY:          mapDesc.MapBorderY,
-		AttackNotifications: make(chan int32, cfg.PlayerCount*2),
+		terrain:             NewTerrain(mapDesc.Terrain),
+		concealments:        NewConcealments(mapDesc.Concealment),
+		hazards:             NewHazards(mapDesc.Hazards, cfg.TicksPerSecond),
+		MapDesc:             *mapDesc,
+		mapData:             mapData,
+		lootTables:          lootTables,
+		lootSeed:            lootSeed,
+		mode:                mode,
+		armorFormula:        armorFormula,
+		damageRand:          rand.New(rand.NewSource(lootSeed)),
+		AttackNotifications: make(chan int32, cfg.PlayerCount*(maxQueuedActions+1)),
 		KillNotifications:   make(chan KillInfo, cfg.PlayerCount),
-		deadPlayers:         make(chan int32, cfg.PlayerCount),
+		AttackRejections:    make(chan AttackRejection, cfg.PlayerCount*(maxQueuedActions+1)),
+		HitEvents:           make(chan *pb.HitEvent, cfg.PlayerCount*(maxQueuedActions+1)),
+		deadPlayers:         make(chan DeathInfo, cfg.PlayerCount),
+		inputs:              newInputQueues(cfg.PlayerCount),
+		appliedInputs:       newInputQueues(cfg.PlayerCount),
+		rewindTicks:         make([]int32, cfg.PlayerCount),
+		lastSequences:       make([]uint32, cfg.PlayerCount),
 	}
 	return gameSession, nil
 }
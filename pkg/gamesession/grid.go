@@ -0,0 +1,250 @@
+package gamesession
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// gridCellsPerPlayer sets grid cell size relative to player radius,
+// most queries cover a couple of players so cells are kept close to that size
+const gridCellsPerPlayer = 4
+
+type gridCell struct {
+	x int
+	y int
+}
+
+type gridSpan struct {
+	minX int
+	minY int
+	maxX int
+	maxY int
+}
+
+func (s gridSpan) size() int {
+	return (s.maxX - s.minX + 1) * (s.maxY - s.minY + 1)
+}
+
+// Grid is uniform spatial hash of objects' bounding boxes, queries return candidate ids which need narrow phase check.
+// Grid is not safe for concurrent modification, queries may run concurrently
+type Grid struct {
+	cellSize float32
+	cells    map[gridCell][]int
+	spans    map[int]gridSpan
+}
+
+func NewGrid(cellSize float32) *Grid {
+	if cellSize <= 0 {
+		cellSize = 1
+	}
+	return &Grid{
+		cellSize: cellSize,
+		cells:    make(map[gridCell][]int),
+		spans:    make(map[int]gridSpan),
+	}
+}
+
+func newGameGrid(playerRadius float32) *Grid {
+	return NewGrid(playerRadius * gridCellsPerPlayer)
+}
+
+// NewEntityGrid indexes map entities by their bounding boxes
+func NewEntityGrid(unmovableEntities map[int]collision2d.Polygon, playerRadius float32) *Grid {
+	grid := newGameGrid(playerRadius)
+	for entityId, entity := range unmovableEntities {
+		grid.InsertPolygon(entityId, entity)
+	}
+	return grid
+}
+
+// indexPlayers puts alive players into grid by their ids
+func indexPlayers(players []*SyncPlayer, playerRadius float32) *Grid {
+	grid := newGameGrid(playerRadius)
+	for _, player := range players {
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 {
+			continue
+		}
+		grid.InsertCircle(int(player.PlayerInfo.PlayerId), player.PlayerInfo.Position.X, player.PlayerInfo.Position.Y, playerRadius)
+	}
+	return grid
+}
+
+// indexItems puts dropped items into grid by their positions in the slice
+func indexItems(items []*pb.DroppedEquipmentItem, playerRadius float32) *Grid {
+	grid := newGameGrid(playerRadius)
+	for i, item := range items {
+		grid.InsertCircle(i, item.Position.X, item.Position.Y, 0)
+	}
+	return grid
+}
+
+// indexProjectiles puts projectiles into grid by their positions in the slice
+func indexProjectiles(projectiles []*pb.Projectile, playerRadius float32) *Grid {
+	grid := newGameGrid(playerRadius)
+	for i, projectile := range projectiles {
+		grid.InsertCircle(i, projectile.Position.X, projectile.Position.Y, 0)
+	}
+	return grid
+}
+
+func (gr *Grid) cellOf(value float32) int {
+	return int(math.Floor(float64(value / gr.cellSize)))
+}
+
+func (gr *Grid) span(minX, minY, maxX, maxY float32) gridSpan {
+	return gridSpan{minX: gr.cellOf(minX), minY: gr.cellOf(minY), maxX: gr.cellOf(maxX), maxY: gr.cellOf(maxY)}
+}
+
+// Insert adds object with given bounding box, previous box of the same id is replaced
+func (gr *Grid) Insert(id int, minX, minY, maxX, maxY float32) {
+	gr.Remove(id)
+	span := gr.span(minX, minY, maxX, maxY)
+	for x := span.minX; x <= span.maxX; x++ {
+		for y := span.minY; y <= span.maxY; y++ {
+			cell := gridCell{x: x, y: y}
+			gr.cells[cell] = append(gr.cells[cell], id)
+		}
+	}
+	gr.spans[id] = span
+}
+
+func (gr *Grid) InsertCircle(id int, x, y, radius float32) {
+	gr.Insert(id, x-radius, y-radius, x+radius, y+radius)
+}
+
+func (gr *Grid) InsertPolygon(id int, polygon collision2d.Polygon) {
+	minX, minY, maxX, maxY := polygonBounds(polygon)
+	gr.Insert(id, minX, minY, maxX, maxY)
+}
+
+func (gr *Grid) Remove(id int) {
+	span, ok := gr.spans[id]
+	if !ok {
+		return
+	}
+	for x := span.minX; x <= span.maxX; x++ {
+		for y := span.minY; y <= span.maxY; y++ {
+			cell := gridCell{x: x, y: y}
+			ids := gr.cells[cell]
+			for i, cellId := range ids {
+				if cellId == id {
+					ids[i] = ids[len(ids)-1]
+					ids = ids[:len(ids)-1]
+					break
+				}
+			}
+			if len(ids) == 0 {
+				delete(gr.cells, cell)
+			} else {
+				gr.cells[cell] = ids
+			}
+		}
+	}
+	delete(gr.spans, id)
+}
+
+// QueryAABB returns sorted ids of objects which cells overlap the box
+func (gr *Grid) QueryAABB(minX, minY, maxX, maxY float32) []int {
+	span := gr.span(minX, minY, maxX, maxY)
+	ids := make([]int, 0)
+	if span.size() > len(gr.cells) {
+		for cell, cellIds := range gr.cells {
+			if cell.x >= span.minX && cell.x <= span.maxX && cell.y >= span.minY && cell.y <= span.maxY {
+				ids = append(ids, cellIds...)
+			}
+		}
+		return uniqueIds(ids)
+	}
+	for x := span.minX; x <= span.maxX; x++ {
+		for y := span.minY; y <= span.maxY; y++ {
+			ids = append(ids, gr.cells[gridCell{x: x, y: y}]...)
+		}
+	}
+	return uniqueIds(ids)
+}
+
+// QueryRadius returns sorted ids of objects which cells overlap the circle's bounding box
+func (gr *Grid) QueryRadius(x, y, radius float32) []int {
+	return gr.QueryAABB(x-radius, y-radius, x+radius, y+radius)
+}
+
+// QuerySegment returns sorted ids of objects in cells crossed by the segment
+func (gr *Grid) QuerySegment(fromX, fromY, toX, toY float32) []int {
+	cellX, cellY := gr.cellOf(fromX), gr.cellOf(fromY)
+	endX, endY := gr.cellOf(toX), gr.cellOf(toY)
+	stepX, tMaxX, tDeltaX := gr.traversal(fromX, toX, cellX)
+	stepY, tMaxY, tDeltaY := gr.traversal(fromY, toY, cellY)
+
+	ids := make([]int, 0)
+	// bounded by number of cells between the ends in case of float errors
+	steps := absInt(endX-cellX) + absInt(endY-cellY)
+	for i := 0; i <= steps; i++ {
+		ids = append(ids, gr.cells[gridCell{x: cellX, y: cellY}]...)
+		if cellX == endX && cellY == endY {
+			break
+		}
+		if tMaxX < tMaxY {
+			cellX += stepX
+			tMaxX += tDeltaX
+		} else {
+			cellY += stepY
+			tMaxY += tDeltaY
+		}
+	}
+	return uniqueIds(ids)
+}
+
+// traversal returns direction, segment parameter of the first cell border crossing and parameter step per cell along one axis
+func (gr *Grid) traversal(from, to float32, cell int) (int, float64, float64) {
+	delta := float64(to - from)
+	if delta == 0 {
+		return 0, math.Inf(1), math.Inf(1)
+	}
+	cellSize := float64(gr.cellSize)
+	if delta > 0 {
+		return 1, (float64(cell+1)*cellSize - float64(from)) / delta, cellSize / delta
+	}
+	return -1, (float64(cell)*cellSize - float64(from)) / delta, -cellSize / delta
+}
+
+func uniqueIds(ids []int) []int {
+	if len(ids) < 2 {
+		return ids
+	}
+	sort.Ints(ids)
+	unique := ids[:1]
+	for _, id := range ids[1:] {
+		if id != unique[len(unique)-1] {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// containsId checks sorted query result for the id
+func containsId(ids []int, id int) bool {
+	i := sort.SearchInts(ids, id)
+	return i < len(ids) && ids[i] == id
+}
+
+func polygonBounds(polygon collision2d.Polygon) (float32, float32, float32, float32) {
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, point := range polygon.CalcPoints {
+		minX = math.Min(minX, polygon.Pos.X+point.X)
+		minY = math.Min(minY, polygon.Pos.Y+point.Y)
+		maxX = math.Max(maxX, polygon.Pos.X+point.X)
+		maxY = math.Max(maxY, polygon.Pos.Y+point.Y)
+	}
+	return float32(minX), float32(minY), float32(maxX), float32(maxY)
+}
+
+func absInt(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
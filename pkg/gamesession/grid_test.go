@@ -0,0 +1,184 @@
+package gamesession
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestGrid(t *testing.T) {
+	grid := NewGrid(10)
+	grid.InsertCircle(0, 5, 5, 2)
+	grid.Insert(1, 12, 12, 38, 18)
+	grid.InsertCircle(2, -15, 45, 3)
+	grid.InsertCircle(3, 95, 95, 1)
+
+	if ids := grid.QueryRadius(8, 8, 1); !reflect.DeepEqual(ids, []int{0}) {
+		t.Fatalf("unexpected radius query result: %v", ids)
+	}
+	if ids := grid.QueryAABB(0, 0, 20, 20); !reflect.DeepEqual(ids, []int{0, 1}) {
+		t.Fatalf("unexpected aabb query result: %v", ids)
+	}
+	if ids := grid.QueryAABB(-100, -100, 100, 100); !reflect.DeepEqual(ids, []int{0, 1, 2, 3}) {
+		t.Fatalf("unexpected large aabb query result: %v", ids)
+	}
+	if ids := grid.QuerySegment(-18, 48, 36, 14); !reflect.DeepEqual(ids, []int{1, 2}) {
+		t.Fatalf("unexpected segment query result: %v", ids)
+	}
+	if ids := grid.QuerySegment(99, 99, 99, 99); !reflect.DeepEqual(ids, []int{3}) {
+		t.Fatalf("unexpected point segment query result: %v", ids)
+	}
+
+	grid.InsertCircle(0, 95, 5, 2)
+	if ids := grid.QueryRadius(5, 5, 1); len(ids) != 0 {
+		t.Fatalf("reinserted object should leave old cells, got: %v", ids)
+	}
+	grid.Remove(1)
+	if ids := grid.QueryAABB(0, 0, 40, 20); len(ids) != 0 {
+		t.Fatalf("removed object should not be returned, got: %v", ids)
+	}
+	if ids := grid.QuerySegment(99, 1, 81, 1); !reflect.DeepEqual(ids, []int{0}) {
+		t.Fatalf("unexpected segment query result: %v", ids)
+	}
+}
+
+func TestEntityGrid(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	// entities which end before the query range started were returned by sort-and-sweep broad phase
+	if ids := gs.entityGrid.QueryAABB(40, 0, 60, 20); len(ids) != 0 {
+		t.Fatalf("expected no entities between x 40 and 60, got: %v", ids)
+	}
+	if ids := gs.entityGrid.QuerySegment(50, 30, 65, 35); len(ids) != 0 {
+		t.Fatalf("expected no entities along the segment, got: %v", ids)
+	}
+	if ids := gs.entityGrid.QueryRadius(20, 65, 5); !reflect.DeepEqual(ids, []int{0}) {
+		t.Fatalf("unexpected entities around the first polygon: %v", ids)
+	}
+}
+
+func TestSnapshotGrids(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.GameState.Projectiles = append(gs.GameState.Projectiles, &SyncProjectile{ProjectileInfo: &pb.Projectile{Position: &pb.Vector{X: 30, Y: 20}}})
+	snapshot := gs.GameState.GetPrevGameState(gs.cfg.PlayerCount, gs.cfg.PlayerRadius)
+	if ids := snapshot.ItemGrid.QueryRadius(45, 40, 1); !containsId(ids, 6) {
+		t.Fatalf("expected potion near (45, 40), got: %v", ids)
+	}
+	if ids := snapshot.ItemGrid.QueryRadius(90, 30, 1); len(ids) != 0 {
+		t.Fatalf("expected no items near (90, 30), got: %v", ids)
+	}
+	if ids := snapshot.ProjectileGrid.QueryRadius(30, 20, 1); !reflect.DeepEqual(ids, []int{0}) {
+		t.Fatalf("unexpected projectiles near (30, 20): %v", ids)
+	}
+
+	// unknown item is not found in the grid instead of indexing out of items
+	gs.processPickUpAction(&pb.PickUpAction{ItemId: 100}, 0)
+}
+
+// makeCrowdedGameSession creates session on test map enlarged to fit given number of players spread over it
+func makeCrowdedGameSession(tb testing.TB, playerCount int) *GameSession {
+	mapData, err := ioutil.ReadFile("../../test/testmap.json")
+	if err != nil {
+		tb.Fatalf("unable to read test map: %v", err)
+	}
+	mapDesc := make(map[string]interface{})
+	if err = json.Unmarshal(mapData, &mapDesc); err != nil {
+		tb.Fatalf("unable to parse test map: %v", err)
+	}
+	random := rand.New(rand.NewSource(1))
+	spawns := make([]float32, 0, playerCount*2)
+	for i := 0; i < playerCount; i++ {
+		spawns = append(spawns, 100+random.Float32()*800, 100+random.Float32()*800)
+	}
+	mapDesc["player_spawns"] = spawns
+	mapDesc["map_border_x"] = 1000
+	mapDesc["map_border_y"] = 1000
+	if mapData, err = json.Marshal(mapDesc); err != nil {
+		tb.Fatalf("unable to marshal crowded map: %v", err)
+	}
+
+	cfg := testGameSessionConfig(playerCount)
+	cfg.PlayerMaxSpeed = 30
+	cfg.PlayerAcceleration = 150
+	cfg.PlayerCollision = true
+	gs, err := NewGameSessionFromMap(cfg, mapData)
+	if err != nil {
+		tb.Fatalf("unable to create crowded game session: %v", err)
+	}
+	for _, player := range gs.GameState.Players {
+		player.PlayerInfo.Hp = math.MaxInt32 / 2
+	}
+	gs.InitPrevGameStates()
+	return gs
+}
+
+func drainNotifications(gs *GameSession) {
+	for {
+		select {
+		case <-gs.AttackNotifications:
+		case <-gs.AttackRejections:
+		case <-gs.KillNotifications:
+		default:
+			return
+		}
+	}
+}
+
+// queueCrowdedInputs makes every tenth player attack and the rest wander around
+func queueCrowdedInputs(gs *GameSession, random *rand.Rand, tick int) {
+	for playerId := range gs.GameState.Players {
+		if (tick+playerId)%10 == 0 {
+			gs.QueueAction(&pb.Action{Action: &pb.Action_Attack{Attack: &pb.AttackAction{}}}, int32(playerId))
+			continue
+		}
+		gs.QueueAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{
+			Direction: &pb.Vector{X: random.Float32()*2 - 1, Y: random.Float32()*2 - 1},
+			Angle:     random.Float32() - 0.5,
+		}}}, int32(playerId))
+	}
+}
+
+func BenchmarkSessionTick100(b *testing.B) {
+	gs := makeCrowdedGameSession(b, 100)
+	random := rand.New(rand.NewSource(2))
+	b.ResetTimer()
+	start := time.Now()
+	for i := 0; i < b.N; i++ {
+		queueCrowdedInputs(gs, random, i)
+		gs.DoSessionTick()
+		drainNotifications(gs)
+	}
+	elapsed := time.Since(start)
+	b.StopTimer()
+	tickBudget := float64(time.Second) / float64(gs.cfg.TicksPerSecond)
+	b.ReportMetric(float64(elapsed)/float64(b.N)/tickBudget*100, "%budget")
+}
+
+func BenchmarkGridQueryRadius(b *testing.B) {
+	gs := makeCrowdedGameSession(b, 100)
+	grid := gs.PrevGameStates[gs.cfg.GameStatesSaved-1].PlayerGrid
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		position := gs.GameState.Players[i%100].PlayerInfo.Position
+		grid.QueryRadius(position.X, position.Y, 10)
+	}
+}
+
+func BenchmarkGridQuerySegment(b *testing.B) {
+	gs := makeCrowdedGameSession(b, 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		gs.hasLineOfSight(0, 0, 100, 100)
+	}
+}
@@ -0,0 +1,52 @@
+package gamesession
+
+import (
+	"github.com/Tarliton/collision2d"
+)
+
+const HazardKillActor = "#hazard"
+
+type HazardJSON struct {
+	Name            string    `json:"name,omitempty"`
+	Vertexes        []float64 `json:"vertexes"`
+	DamagePerSecond float32   `json:"damage_per_second"`
+}
+
+// Hazard is map area damaging players inside it, like fire or poison swamp
+type Hazard struct {
+	area          collision2d.Polygon
+	damagePerTick float32
+}
+
+func NewHazards(hazardDescs []HazardJSON, ticksPerSecond int) []Hazard {
+	hazards := make([]Hazard, 0, len(hazardDescs))
+	for _, desc := range hazardDescs {
+		hazards = append(hazards, Hazard{
+			area:          collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, desc.Vertexes),
+			damagePerTick: desc.DamagePerSecond / float32(ticksPerSecond),
+		})
+	}
+	return hazards
+}
+
+func (g *GameSession) applyHazards() {
+	for _, player := range g.GameState.Players {
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 {
+			continue
+		}
+		point := collision2d.NewVector(float64(player.PlayerInfo.Position.X), float64(player.PlayerInfo.Position.Y))
+		for _, hazard := range g.hazards {
+			if collision2d.PointInPolygon(point, hazard.area) {
+				player.hazardDamage += hazard.damagePerTick
+			}
+		}
+		damageDealt := int32(player.hazardDamage)
+		if damageDealt == 0 {
+			continue
+		}
+		player.hazardDamage -= float32(damageDealt)
+		if g.damagePlayer(player, damageDealt) {
+			g.deadPlayers <- DeathInfo{PlayerId: player.PlayerInfo.PlayerId, AttackerId: HazardKiller}
+		}
+	}
+}
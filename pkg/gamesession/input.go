@@ -0,0 +1,100 @@
+package gamesession
+
+import (
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// maxQueuedActions limits actions of one player applied in a single tick, extra actions are dropped
+const maxQueuedActions = 8
+
+// inputQueue keeps actions of all players in arrival order and counts them per player
+type inputQueue struct {
+	inputs []*pb.ReplayInput
+	counts []int
+}
+
+func newInputQueues(playerCount int) *inputQueue {
+	return &inputQueue{counts: make([]int, playerCount)}
+}
+
+func (q *inputQueue) push(input *pb.ReplayInput) bool {
+	if q.counts[input.PlayerId] >= maxQueuedActions {
+		return false
+	}
+	q.counts[input.PlayerId]++
+	q.inputs = append(q.inputs, input)
+	return true
+}
+
+func (q *inputQueue) reset() {
+	q.inputs = q.inputs[:0]
+	for playerId := range q.counts {
+		q.counts[playerId] = 0
+	}
+}
+
+// QueueAction stores client action until the start of the next tick, safe for concurrent use
+func (g *GameSession) QueueAction(action *pb.Action, playerId int32) {
+	g.QueueSequencedAction(action, playerId, 0)
+}
+
+// QueueSequencedAction is QueueAction for inputs numbered by client, sequence should grow with every input,
+// duplicate and out of order inputs are rejected, 0 means input is not numbered
+func (g *GameSession) QueueSequencedAction(action *pb.Action, playerId int32, sequence uint32) bool {
+	g.inputsLock.Lock()
+	defer g.inputsLock.Unlock()
+	if playerId < 0 || int(playerId) >= len(g.inputs.counts) {
+		return false
+	}
+	if sequence != 0 && sequence <= g.lastSequences[playerId] {
+		return false
+	}
+	if !g.queueInput(&pb.ReplayInput{PlayerId: playerId, Action: action, RewindTicks: g.rewindTicks[playerId], Sequence: sequence}) {
+		return false
+	}
+	if sequence != 0 {
+		g.lastSequences[playerId] = sequence
+	}
+	return true
+}
+
+// QueueInput stores recorded input with its own rewind, safe for concurrent use
+func (g *GameSession) QueueInput(input *pb.ReplayInput) {
+	g.inputsLock.Lock()
+	defer g.inputsLock.Unlock()
+	if input.PlayerId < 0 || int(input.PlayerId) >= len(g.inputs.counts) {
+		return
+	}
+	g.queueInput(input)
+}
+
+func (g *GameSession) queueInput(input *pb.ReplayInput) bool {
+	return g.inputs.push(input)
+}
+
+// applyQueuedActions applies actions in order of arrival, so no player wins contested actions by player id,
+// should be called under session lock
+func (g *GameSession) applyQueuedActions() {
+	g.appliedInputs.reset()
+	g.inputsLock.Lock()
+	g.inputs, g.appliedInputs = g.appliedInputs, g.inputs
+	g.inputsLock.Unlock()
+	for _, input := range g.appliedInputs.inputs {
+		g.applyInput(input)
+	}
+}
+
+func (g *GameSession) applyInput(input *pb.ReplayInput) {
+	player := g.GameState.Players[input.PlayerId]
+	player.rewindTicks = input.RewindTicks
+	if input.Sequence != 0 {
+		player.PlayerInfo.LastProcessedInput = input.Sequence
+	}
+	g.applyAction(input.Action, input.PlayerId)
+}
+
+// AppliedInputs returns actions applied during the last tick in order of application,
+// should be called by the goroutine running ticks
+func (g *GameSession) AppliedInputs() []*pb.ReplayInput {
+	return append(make([]*pb.ReplayInput, 0, len(g.appliedInputs.inputs)), g.appliedInputs.inputs...)
+}
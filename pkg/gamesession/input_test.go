@@ -0,0 +1,82 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestQueuedActions(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 40, Y: 40}
+	gs.DoSessionTick()
+	gs.QueueAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 6}}}, 1)
+	gs.QueueAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 6}}}, 0)
+	if gs.GameState.Items[6].pickedUp {
+		t.Fatal("queued action should not be applied before tick")
+	}
+	gs.DoSessionTick()
+	if len(player.PlayerInfo.Equipment.Consumables) != 0 || len(enemy.PlayerInfo.Equipment.Consumables) != 1 {
+		t.Fatalf("expected actions to be applied in arrival order, consumables: %v, %v",
+			len(player.PlayerInfo.Equipment.Consumables), len(enemy.PlayerInfo.Equipment.Consumables))
+	}
+
+	for i := 0; i < maxQueuedActions+2; i++ {
+		gs.QueueAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 1}}}}, 0)
+	}
+	gs.QueueAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 1}}}}, 4)
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 50+maxQueuedActions, 40, 0)
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 50+maxQueuedActions, 40, 0)
+}
+
+func TestSequencedActions(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	move := &pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 1}}}}
+
+	for _, tc := range []struct {
+		sequence uint32
+		accepted bool
+	}{
+		{sequence: 1, accepted: true},
+		{sequence: 1, accepted: false},
+		{sequence: 3, accepted: true},
+		{sequence: 2, accepted: false},
+		{sequence: 0, accepted: true},
+	} {
+		if accepted := gs.QueueSequencedAction(move, 0, tc.sequence); accepted != tc.accepted {
+			t.Fatalf("input %v: expected accepted %v, got %v", tc.sequence, tc.accepted, accepted)
+		}
+	}
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 53, 40, 0)
+	if player.PlayerInfo.LastProcessedInput != 3 {
+		t.Fatalf("expected last processed input 3, got %v", player.PlayerInfo.LastProcessedInput)
+	}
+	if state := gs.StateProto(); state.Players[0].LastProcessedInput != 3 || state.Players[1].LastProcessedInput != 0 {
+		t.Fatalf("state should acknowledge processed inputs, got %v, %v",
+			state.Players[0].LastProcessedInput, state.Players[1].LastProcessedInput)
+	}
+
+	for i := uint32(0); i < maxQueuedActions; i++ {
+		gs.QueueSequencedAction(move, 0, 10+i)
+	}
+	if gs.QueueSequencedAction(move, 0, 20) {
+		t.Fatal("expected input to be rejected by full queue")
+	}
+	gs.DoSessionTick()
+	if !gs.QueueSequencedAction(move, 0, 20) {
+		t.Fatal("input rejected by full queue should be accepted later")
+	}
+}
@@ -0,0 +1,56 @@
+package gamesession
+
+import (
+	"math"
+)
+
+// knockbackContactGap keeps player body slightly apart from the wall it was knocked into
+const knockbackContactGap = 0.001
+
+// applyKnockback sweeps player's circle along knockback vector until it reaches map border or map entity,
+// returns true when player was stopped before the end of the vector
+func (g *GameSession) applyKnockback(player *SyncPlayer, knockbackX, knockbackY float32) bool {
+	length := CalculateDistance(0, 0, knockbackX, knockbackY)
+	if length == 0 {
+		return false
+	}
+	position := player.PlayerInfo.Position
+	reach := float32(1)
+	reach = borderReach(reach, position.X, knockbackX, g.mapBorderX)
+	reach = borderReach(reach, position.Y, knockbackY, g.mapBorderY)
+	stopped := reach < 1
+
+	radius := g.cfg.PlayerRadius
+	minX, maxX := position.X, position.X+knockbackX*reach
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	minY, maxY := position.Y, position.Y+knockbackY*reach
+	if minY > maxY {
+		minY, maxY = maxY, minY
+	}
+	for _, entityId := range g.entityGrid.QueryAABB(minX-radius, minY-radius, maxX+radius, maxY+radius) {
+		part, hit := sweepCircle(position.X, position.Y, radius, knockbackX, knockbackY, g.unmovableEntities[entityId])
+		if !hit || part >= reach {
+			continue
+		}
+		reach = float32(math.Max(0, float64(part-knockbackContactGap/length)))
+		stopped = true
+	}
+	position.X += knockbackX * reach
+	position.Y += knockbackY * reach
+	return stopped
+}
+
+// borderReach limits part of the shift along single axis which keeps coordinate inside [0, border]
+func borderReach(reach, coordinate, shift, border float32) float32 {
+	if coordinate+shift < 0 {
+		reach = float32(math.Min(float64(reach), float64(-coordinate/shift)))
+	} else if coordinate+shift > border {
+		reach = float32(math.Min(float64(reach), float64((border-coordinate)/shift)))
+	}
+	if reach < 0 {
+		return 0
+	}
+	return reach
+}
@@ -0,0 +1,73 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestKnockbackCollision(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	player.PlayerInfo.Equipment.Weapon.GetWeaponChars().WallSlamDamage = 5
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	checkPlayerMovement(t, enemy, 50, 52, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("free knockback should not deal wall slam damage, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 89}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 99}
+	enemy.PlayerInfo.Hp = 100
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	checkPlayerMovement(t, enemy, 50, 100, 0)
+	if enemy.PlayerInfo.Hp != 85 {
+		t.Fatalf("expected wall slam damage from map border, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 53.5, Y: 55}
+	player.PlayerInfo.Angle = 0
+	enemy.PlayerInfo.Position = &pb.Vector{X: 63.5, Y: 55}
+	enemy.PlayerInfo.Hp = 100
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	checkPlayerMovement(t, enemy, 65, 55, 0)
+	if enemy.PlayerInfo.Hp != 85 {
+		t.Fatalf("expected wall slam damage from map entity, hp: %v", enemy.PlayerInfo.Hp)
+	}
+}
+
+func TestSweepCircle(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	triangle := gs.unmovableEntities[1]
+
+	for name, tc := range map[string]struct {
+		x, y, shiftX, shiftY float32
+		part                 float32
+		hit                  bool
+	}{
+		"vertex": {x: 60, y: 74, shiftX: 30, part: 17.0 / 30, hit: true},
+		"edge":   {x: 60, y: 65, shiftX: 20, part: (75 - 5*math.Sqrt2 - 60) / 20, hit: true},
+		"miss":   {x: 60, y: 76, shiftX: 30},
+		"short":  {x: 60, y: 65, shiftX: 5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			part, hit := sweepCircle(tc.x, tc.y, gs.cfg.PlayerRadius, tc.shiftX, tc.shiftY, triangle)
+			if hit != tc.hit || hit && math.Abs(float64(part-tc.part)) > 0.001 {
+				t.Fatalf("expected hit %v at %.3f, got: %v at %.3f", tc.hit, tc.part, hit, part)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package gamesession
+
+import (
+	"math"
+	"time"
+)
+
+// SetPlayerLatency updates how far back attacks of player are checked, applies to actions queued afterwards,
+// safe for concurrent use
+func (g *GameSession) SetPlayerLatency(playerId int32, rtt time.Duration) {
+	if g.cfg.MaxRewind <= 0 {
+		return
+	}
+	viewDelay := rtt.Seconds() + float64(g.cfg.InterpolationDelay)
+	rewindTicks := int32(math.Round(viewDelay * float64(g.cfg.TicksPerSecond)))
+	if maxRewindTicks := int32(math.Round(float64(g.cfg.MaxRewind) * float64(g.cfg.TicksPerSecond))); rewindTicks > maxRewindTicks {
+		rewindTicks = maxRewindTicks
+	}
+	g.inputsLock.Lock()
+	defer g.inputsLock.Unlock()
+	if playerId < 0 || int(playerId) >= len(g.rewindTicks) {
+		return
+	}
+	g.rewindTicks[playerId] = rewindTicks
+}
+
+// rewoundState returns saved game state rewindTicks older than the one sent to clients,
+// the oldest one is returned if history is too short
+func (g *GameSession) rewoundState(rewindTicks int32) *PrevGameState {
+	newest := len(g.PrevGameStates) - 1
+	targetTick := g.PrevGameStates[newest].Tick - (g.cfg.GameStatesShiftBack - 1) - int(rewindTicks)
+	for i := newest; i > 0; i-- {
+		if g.PrevGameStates[i].Tick <= targetTick {
+			return &g.PrevGameStates[i]
+		}
+	}
+	return &g.PrevGameStates[0]
+}
@@ -0,0 +1,51 @@
+package gamesession
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestLagCompensation(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.InterpolationDelay = 0.1
+	gs.cfg.MaxRewind = 0.2
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	attack := &pb.Action{Action: &pb.Action_Attack{Attack: &pb.AttackAction{}}}
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	gs.InitPrevGameStates()
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 70}
+	for i := 0; i < 5; i++ {
+		gs.DoSessionTick()
+	}
+
+	gs.ProcessAction(attack, 0)
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("attack without latency should check current positions, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.nextAttackTick = 0
+	gs.SetPlayerLatency(0, 100*time.Millisecond)
+	gs.ProcessAction(attack, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("attack should be checked against positions seen by attacker, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.nextAttackTick = 0
+	gs.cfg.MaxRewind = 0.1
+	gs.SetPlayerLatency(0, time.Second)
+	gs.QueueAction(attack, 0)
+	gs.DoSessionTick()
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("rewind should be limited by max rewind, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if inputs := gs.AppliedInputs(); len(inputs) != 1 || inputs[0].RewindTicks != 3 {
+		t.Fatalf("applied input should keep its rewind, got: %v", inputs)
+	}
+}
@@ -0,0 +1,237 @@
+package gamesession
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const DefaultLootTable = "default"
+
+type LootEntryJSON struct {
+	Type   string  `json:"type"`
+	Rarity string  `json:"rarity"`
+	Weight float32 `json:"weight"`
+}
+
+type LootStatsJSON struct {
+	AttackPower           []float32          `json:"attack_power"`
+	Range                 []float32          `json:"range"`
+	AttackCone            []float32          `json:"attack_cone"`
+	KnockbackPower        []float32          `json:"knockback_power"`
+	ProjectileSpeed       []float32          `json:"projectile_speed"`
+	ProjectileMaxDistance []float32          `json:"projectile_max_distance"`
+	Ammo                  []float32          `json:"ammo"`
+	AttackInterval        []float32          `json:"attack_interval"`
+	WindUp                []float32          `json:"wind_up"`
+	WallSlamDamage        []float32          `json:"wall_slam_damage"`
+	HpBuff                []float32          `json:"hp_buff"`
+	DamageReduction       []float32          `json:"damage_reduction"`
+	ConsumableType        string             `json:"consumable_type"`
+	Heal                  []float32          `json:"heal"`
+	ChannelTime           []float32          `json:"channel_time"`
+	OnHitEffects          []StatusEffectJSON `json:"on_hit_effects"`
+}
+
+type LootTablesJSON struct {
+	DefaultTable string                              `json:"default_table"`
+	Tables       map[string][]LootEntryJSON          `json:"tables"`
+	Stats        map[string]map[string]LootStatsJSON `json:"stats"`
+}
+
+type LootGenerator struct {
+	tables *LootTablesJSON
+	rng    *rand.Rand
+}
+
+func DefaultLootTables() *LootTablesJSON {
+	return &LootTablesJSON{
+		DefaultTable: DefaultLootTable,
+		Tables: map[string][]LootEntryJSON{
+			DefaultLootTable: {{Type: pb.EquipmentItemType_WEAPON.String(), Rarity: pb.EquipmentItemRarity_COMMON.String(), Weight: 1}},
+		},
+		Stats: map[string]map[string]LootStatsJSON{
+			pb.EquipmentItemType_WEAPON.String(): {
+				pb.EquipmentItemRarity_COMMON.String(): {
+					AttackPower:    []float32{15},
+					Range:          []float32{10},
+					AttackCone:     []float32{math.Pi / 6},
+					KnockbackPower: []float32{3},
+					AttackInterval: []float32{0.6},
+				},
+			},
+		},
+	}
+}
+
+func LoadLootTables(filename string) (*LootTablesJSON, error) {
+	lootFile, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening loot tables file: %v", err)
+	}
+	defer lootFile.Close()
+	bytes, _ := ioutil.ReadAll(lootFile)
+	var lootTables LootTablesJSON
+	err = json.Unmarshal(bytes, &lootTables)
+	if err != nil {
+		return nil, fmt.Errorf("Error unmarshalling loot tables file: %v", err)
+	}
+	if lootTables.DefaultTable == "" {
+		lootTables.DefaultTable = DefaultLootTable
+	}
+	if err = lootTables.Validate(); err != nil {
+		return nil, err
+	}
+	return &lootTables, nil
+}
+
+func (lt *LootTablesJSON) Validate() error {
+	if _, found := lt.Tables[lt.DefaultTable]; !found {
+		return fmt.Errorf("default loot table %q is not defined", lt.DefaultTable)
+	}
+	for name, entries := range lt.Tables {
+		if len(entries) == 0 {
+			return fmt.Errorf("loot table %q has no entries", name)
+		}
+		for _, entry := range entries {
+			if entry.Weight <= 0 {
+				return fmt.Errorf("loot table %q has entry with non-positive weight", name)
+			}
+			if _, found := pb.EquipmentItemType_value[entry.Type]; !found {
+				return fmt.Errorf("loot table %q has entry with unknown item type %q", name, entry.Type)
+			}
+			rarity, found := pb.EquipmentItemRarity_value[entry.Rarity]
+			if !found || rarity == int32(pb.EquipmentItemRarity_DEFAULT) {
+				return fmt.Errorf("loot table %q has entry with invalid rarity %q", name, entry.Rarity)
+			}
+			stats, found := lt.Stats[entry.Type][entry.Rarity]
+			if !found {
+				return fmt.Errorf("no stats defined for %v %v items", entry.Rarity, entry.Type)
+			}
+			for _, effect := range stats.OnHitEffects {
+				if _, err := effect.ToProto(); err != nil {
+					return fmt.Errorf("invalid on-hit effect of %v %v items: %v", entry.Rarity, entry.Type, err)
+				}
+			}
+			if entry.Type != pb.EquipmentItemType_CONSUMABLE.String() {
+				continue
+			}
+			if _, found := pb.ConsumableType_value[stats.ConsumableType]; !found {
+				return fmt.Errorf("unknown consumable type %q for %v %v items", stats.ConsumableType, entry.Rarity, entry.Type)
+			}
+		}
+	}
+	return nil
+}
+
+func NewLootGenerator(tables *LootTablesJSON, seed int64) *LootGenerator {
+	return &LootGenerator{
+		tables: tables,
+		rng:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (lg *LootGenerator) SpawnItems(mapDesc *MapDescription) ([]*SyncItem, error) {
+	amountOfItemsToSpawn := int(float64(len(mapDesc.LootSpawns)) / 2.0)
+	if len(mapDesc.LootSpotTables) != 0 && len(mapDesc.LootSpotTables) != amountOfItemsToSpawn {
+		return nil, fmt.Errorf("loot spot tables should be set for every loot spot")
+	}
+	items := make([]*SyncItem, 0, amountOfItemsToSpawn)
+	for i := 0; i < amountOfItemsToSpawn; i++ {
+		tableName := lg.tables.DefaultTable
+		if len(mapDesc.LootSpotTables) != 0 && mapDesc.LootSpotTables[i] != "" {
+			tableName = mapDesc.LootSpotTables[i]
+		}
+		item, err := lg.Generate(tableName, int32(i))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &SyncItem{ItemInfo: &pb.DroppedEquipmentItem{
+			Item:     item,
+			Position: &pb.Vector{X: mapDesc.LootSpawns[i*2], Y: mapDesc.LootSpawns[i*2+1]},
+		}})
+	}
+	return items, nil
+}
+
+func (lg *LootGenerator) Generate(tableName string, itemId int32) (*pb.EquipmentItem, error) {
+	entries, found := lg.tables.Tables[tableName]
+	if !found {
+		return nil, fmt.Errorf("loot table %q is not defined", tableName)
+	}
+	var totalWeight float32
+	for _, entry := range entries {
+		totalWeight += entry.Weight
+	}
+	roll := lg.rng.Float32() * totalWeight
+	entry := entries[len(entries)-1]
+	for _, possibleEntry := range entries {
+		if roll < possibleEntry.Weight {
+			entry = possibleEntry
+			break
+		}
+		roll -= possibleEntry.Weight
+	}
+
+	stats := lg.tables.Stats[entry.Type][entry.Rarity]
+	item := &pb.EquipmentItem{
+		Type:   pb.EquipmentItemType(pb.EquipmentItemType_value[entry.Type]),
+		Rarity: pb.EquipmentItemRarity(pb.EquipmentItemRarity_value[entry.Rarity]),
+		ItemId: itemId,
+	}
+	switch item.Type {
+	case pb.EquipmentItemType_WEAPON:
+		onHitEffects := make([]*pb.StatusEffectDefinition, 0, len(stats.OnHitEffects))
+		for _, effect := range stats.OnHitEffects {
+			definition, err := effect.ToProto()
+			if err != nil {
+				return nil, err
+			}
+			onHitEffects = append(onHitEffects, definition)
+		}
+		item.Characteristics = &pb.EquipmentItem_WeaponChars{WeaponChars: &pb.WeaponCharacteristics{
+			AttackPower:           int32(math.Round(float64(lg.roll(stats.AttackPower)))),
+			Range:                 lg.roll(stats.Range),
+			AttackCone:            lg.roll(stats.AttackCone),
+			KnockbackPower:        lg.roll(stats.KnockbackPower),
+			ProjectileSpeed:       lg.roll(stats.ProjectileSpeed),
+			ProjectileMaxDistance: lg.roll(stats.ProjectileMaxDistance),
+			Ammo:                  int32(math.Round(float64(lg.roll(stats.Ammo)))),
+			AttackInterval:        lg.roll(stats.AttackInterval),
+			WindUp:                lg.roll(stats.WindUp),
+			WallSlamDamage:        int32(math.Round(float64(lg.roll(stats.WallSlamDamage)))),
+			OnHitEffects:          onHitEffects,
+		}}
+	case pb.EquipmentItemType_HELMET:
+		// helmets give hp unless loot table makes them mitigate head hits
+		if len(stats.DamageReduction) > 0 {
+			item.Characteristics = &pb.EquipmentItem_DamageReduction{DamageReduction: int32(math.Round(float64(lg.roll(stats.DamageReduction))))}
+		} else {
+			item.Characteristics = &pb.EquipmentItem_HpBuff{HpBuff: int32(math.Round(float64(lg.roll(stats.HpBuff))))}
+		}
+	case pb.EquipmentItemType_ARMOR:
+		item.Characteristics = &pb.EquipmentItem_DamageReduction{DamageReduction: int32(math.Round(float64(lg.roll(stats.DamageReduction))))}
+	case pb.EquipmentItemType_CONSUMABLE:
+		item.Characteristics = &pb.EquipmentItem_ConsumableChars{ConsumableChars: &pb.ConsumableCharacteristics{
+			Type:        pb.ConsumableType(pb.ConsumableType_value[stats.ConsumableType]),
+			Heal:        int32(math.Round(float64(lg.roll(stats.Heal)))),
+			ChannelTime: lg.roll(stats.ChannelTime),
+		}}
+	}
+	return item, nil
+}
+
+func (lg *LootGenerator) roll(statRange []float32) float32 {
+	switch len(statRange) {
+	case 0:
+		return 0
+	case 1:
+		return statRange[0]
+	}
+	return statRange[0] + lg.rng.Float32()*(statRange[1]-statRange[0])
+}
@@ -0,0 +1,97 @@
+package gamesession
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestLootGenerator(t *testing.T) {
+	absPath, _ := filepath.Abs("")
+	lootTables, err := LoadLootTables(filepath.Join(absPath[:len(absPath)-16], "/test/loottables.json"))
+	if err != nil {
+		t.Fatalf("unable to load test loot tables: %v", err)
+	}
+
+	mapDesc := &MapDescription{
+		LootSpawns:     []float32{10, 10, 20, 20, 30, 30, 40, 40, 50, 50, 60, 60},
+		LootSpotTables: []string{"", "", "high_tier", "", "high_tier", ""},
+	}
+
+	items, err := NewLootGenerator(lootTables, 42).SpawnItems(mapDesc)
+	if err != nil {
+		t.Fatalf("unable to spawn items: %v", err)
+	}
+	sameItems, err := NewLootGenerator(lootTables, 42).SpawnItems(mapDesc)
+	if err != nil {
+		t.Fatalf("unable to spawn items: %v", err)
+	}
+
+	if len(items) != 6 {
+		t.Fatalf("expected 6 items to be spawned, got: %v", len(items))
+	}
+	for i, item := range items {
+		if item.ItemInfo.Item.ItemId != int32(i) {
+			t.Fatalf("expected item #%v to have id %v, got: %v", i, i, item.ItemInfo.Item.ItemId)
+		}
+		if item.ItemInfo.Item.String() != sameItems[i].ItemInfo.Item.String() {
+			t.Fatalf("expected same seed to spawn same items, got: %v and %v", item.ItemInfo.Item, sameItems[i].ItemInfo.Item)
+		}
+		if item.ItemInfo.Position.X != mapDesc.LootSpawns[i*2] || item.ItemInfo.Position.Y != mapDesc.LootSpawns[i*2+1] {
+			t.Fatalf("item #%v not spawned at its loot spot", i)
+		}
+		if item.ItemInfo.Item.Rarity == pb.EquipmentItemRarity_DEFAULT {
+			t.Fatalf("item #%v should not have default rarity", i)
+		}
+	}
+	for _, i := range []int{2, 4} {
+		if items[i].ItemInfo.Item.Rarity < pb.EquipmentItemRarity_RARE {
+			t.Fatalf("expected high tier loot spot #%v to spawn at least rare item, got: %v", i, items[i].ItemInfo.Item.Rarity)
+		}
+	}
+
+	mapDesc.LootSpotTables = []string{"", "unknown", "", "", "", ""}
+	if _, err = NewLootGenerator(lootTables, 42).SpawnItems(mapDesc); err == nil {
+		t.Fatal("expected error for unknown loot table")
+	}
+}
+
+func TestLootGeneratorStats(t *testing.T) {
+	lootTables := &LootTablesJSON{
+		DefaultTable: DefaultLootTable,
+		Tables: map[string][]LootEntryJSON{
+			DefaultLootTable: {{Type: "HELMET", Rarity: "EPIC", Weight: 1}},
+		},
+		Stats: map[string]map[string]LootStatsJSON{
+			"HELMET": {"EPIC": {HpBuff: []float32{35, 40}}},
+		},
+	}
+	if err := lootTables.Validate(); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	lootGenerator := NewLootGenerator(lootTables, 7)
+	for i := 0; i < 20; i++ {
+		item, err := lootGenerator.Generate(DefaultLootTable, int32(i))
+		if err != nil {
+			t.Fatalf("unable to generate item: %v", err)
+		}
+		if item.Type != pb.EquipmentItemType_HELMET || item.Rarity != pb.EquipmentItemRarity_EPIC {
+			t.Fatalf("expected epic helmet, got: %v %v", item.Rarity, item.Type)
+		}
+		if item.GetHpBuff() < 35 || item.GetHpBuff() > 40 {
+			t.Fatalf("expected hp buff in range [35, 40], got: %v", item.GetHpBuff())
+		}
+	}
+
+	lootTables.Stats["HELMET"]["EPIC"] = LootStatsJSON{DamageReduction: []float32{3, 3}}
+	if item, err := lootGenerator.Generate(DefaultLootTable, 20); err != nil || item.GetDamageReduction() != 3 {
+		t.Fatalf("expected helmet with damage reduction 3, got: %v, %v", item, err)
+	}
+
+	lootTables.Tables[DefaultLootTable] = append(lootTables.Tables[DefaultLootTable], LootEntryJSON{Type: "ARMOR", Rarity: "EPIC", Weight: 1})
+	if err := lootTables.Validate(); err == nil {
+		t.Fatal("expected validation error for entry without stats")
+	}
+}
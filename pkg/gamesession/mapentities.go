@@ -0,0 +1,130 @@
+package gamesession
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+type SyncMapEntity struct {
+	EntityInfo *pb.MapEntity
+	area       collision2d.Polygon
+	dirty      bool
+}
+
+func NewMapEntities(polygons []PolygonJSON) (map[int]collision2d.Polygon, []*SyncMapEntity, error) {
+	unmovableEntities := make(map[int]collision2d.Polygon, len(polygons))
+	mapEntities := make([]*SyncMapEntity, 0, len(polygons))
+	for i, polygon := range polygons {
+		entityType := pb.MapEntityType_WALL
+		if polygon.Type != "" {
+			value, ok := pb.MapEntityType_value[polygon.Type]
+			if !ok {
+				return nil, nil, fmt.Errorf("unknown map entity type: %v", polygon.Type)
+			}
+			entityType = pb.MapEntityType(value)
+		}
+		area := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, polygon.Vertexes)
+		unmovableEntities[i] = area
+		mapEntities = append(mapEntities, &SyncMapEntity{
+			EntityInfo: &pb.MapEntity{EntityId: int32(i), Type: entityType, Hp: polygon.Hp},
+			area:       area,
+		})
+	}
+	return unmovableEntities, mapEntities, nil
+}
+
+// isDynamic returns true for entities which state can change during the game
+func (e *SyncMapEntity) isDynamic() bool {
+	return e.EntityInfo.Type != pb.MapEntityType_WALL || e.EntityInfo.Hp > 0
+}
+
+func (g *GameSession) damageMapEntity(entityId int, damage int32) {
+	entity := g.GameState.MapEntities[entityId]
+	if entity.EntityInfo.Destroyed || entity.EntityInfo.Hp <= 0 || damage <= 0 {
+		return
+	}
+	entity.EntityInfo.Hp -= damage
+	if entity.EntityInfo.Hp <= 0 {
+		entity.EntityInfo.Hp = 0
+		entity.EntityInfo.Destroyed = true
+		entity.dirty = true
+	}
+}
+
+// processEntityHits damages the first map entity blocking the attack, destructible or not, so hits do not go through walls
+func (g *GameSession) processEntityHits(player *pb.Player) {
+	weaponChars := player.Equipment.Weapon.GetWeaponChars()
+	reach := g.cfg.PlayerRadius + weaponChars.GetRange()
+	endX := player.Position.X + reach*float32(math.Cos(float64(player.Angle)))
+	endY := player.Position.Y + reach*float32(math.Sin(float64(player.Angle)))
+	nearestId, nearestEntry := -1, float32(math.MaxFloat32)
+	for _, entityId := range g.entityGrid.QuerySegment(player.Position.X, player.Position.Y, endX, endY) {
+		entry, crossing := segmentPolygonEntry(player.Position.X, player.Position.Y, endX, endY, g.unmovableEntities[entityId])
+		if crossing && entry < nearestEntry {
+			nearestId, nearestEntry = entityId, entry
+		}
+	}
+	if nearestId >= 0 {
+		g.damageMapEntity(nearestId, weaponChars.GetAttackPower())
+	}
+}
+
+func (g *GameSession) processInteractAction(interactAction *pb.InteractAction, playerId int32) {
+	if interactAction.EntityId < 0 || int(interactAction.EntityId) >= len(g.GameState.MapEntities) {
+		return
+	}
+	entity := g.GameState.MapEntities[int(interactAction.EntityId)]
+	if entity.EntityInfo.Type != pb.MapEntityType_DOOR {
+		return
+	}
+	player := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players[int(playerId)]
+	interactBody := squareBody(player.Position.X, player.Position.Y, g.cfg.PlayerRadius+g.cfg.PlayerPickUpRange)
+	if colliding, _ := collision2d.TestPolygonPolygon(interactBody, entity.area); !colliding {
+		return
+	}
+	if entity.EntityInfo.Destroyed {
+		return
+	}
+	entity.EntityInfo.Open = !entity.EntityInfo.Open
+	entity.dirty = true
+}
+
+// updateMapEntities syncs collision set with destroyed and opened entities, should be called under session lock
+func (g *GameSession) updateMapEntities() {
+	for _, entity := range g.GameState.MapEntities {
+		if !entity.dirty {
+			continue
+		}
+		entity.dirty = false
+		entityId := int(entity.EntityInfo.EntityId)
+		_, blocking := g.unmovableEntities[entityId]
+		shouldBlock := !entity.EntityInfo.Destroyed && !entity.EntityInfo.Open
+		if shouldBlock && !blocking {
+			if g.isEntityOccupied(entity) {
+				entity.EntityInfo.Open = true
+				continue
+			}
+			g.unmovableEntities[entityId] = entity.area
+			g.entityGrid.InsertPolygon(entityId, entity.area)
+		} else if !shouldBlock && blocking {
+			delete(g.unmovableEntities, entityId)
+			g.entityGrid.Remove(entityId)
+		}
+	}
+}
+
+func (g *GameSession) isEntityOccupied(entity *SyncMapEntity) bool {
+	for _, player := range g.GameState.Players {
+		if player.Position != 0 {
+			continue
+		}
+		playerBody := squareBody(player.PlayerInfo.Position.X, player.PlayerInfo.Position.Y, g.cfg.PlayerRadius)
+		if colliding, _ := collision2d.TestPolygonPolygon(playerBody, entity.area); colliding {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestDoorInteraction(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	door := gs.GameState.MapEntities[3]
+	moveLeft := &pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: -10, Y: 0}}}}
+	interact := &pb.Action{Action: &pb.Action_Interact{Interact: &pb.InteractAction{EntityId: 3}}}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 30, Y: 35}
+	gs.DoSessionTick()
+	gs.ProcessAction(moveLeft, 0)
+	checkPlayerMovement(t, player, 30, 35, 0)
+
+	gs.ProcessAction(interact, 0)
+	gs.DoSessionTick()
+	if !door.EntityInfo.Open {
+		t.Fatal("expected door to be opened")
+	}
+	if _, ok := gs.unmovableEntities[3]; ok {
+		t.Fatal("opened door should be removed from collision set")
+	}
+	gs.ProcessAction(moveLeft, 0)
+	checkPlayerMovement(t, player, 20, 35, 0)
+
+	gs.DoSessionTick()
+	gs.ProcessAction(interact, 0)
+	gs.DoSessionTick()
+	if !door.EntityInfo.Open {
+		t.Fatal("door should not be closed while player stands in it")
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 30, Y: 35}
+	gs.DoSessionTick()
+	gs.ProcessAction(interact, 0)
+	gs.DoSessionTick()
+	if door.EntityInfo.Open {
+		t.Fatal("expected door to be closed")
+	}
+	if _, ok := gs.unmovableEntities[3]; !ok {
+		t.Fatal("closed door should be returned to collision set")
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 35}
+	gs.DoSessionTick()
+	gs.ProcessAction(interact, 0)
+	gs.DoSessionTick()
+	if door.EntityInfo.Open {
+		t.Fatal("door should not be opened from afar")
+	}
+}
+
+func TestDestructibleEntity(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	crate := gs.GameState.MapEntities[4]
+	gs.GameState.Players[1].PlayerInfo.Position = &pb.Vector{X: 70, Y: 30}
+	player.PlayerInfo.Position = &pb.Vector{X: 80, Y: 12}
+	player.PlayerInfo.Angle = 0
+	gs.DoSessionTick()
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if crate.EntityInfo.Hp != 10 {
+		t.Fatalf("expected attack to damage crate, hp: %v", crate.EntityInfo.Hp)
+	}
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if !crate.EntityInfo.Destroyed {
+		t.Fatalf("expected crate to be destroyed, hp: %v", crate.EntityInfo.Hp)
+	}
+	gs.DoSessionTick()
+	if _, ok := gs.unmovableEntities[4]; ok {
+		t.Fatal("destroyed crate should be removed from collision set")
+	}
+	for _, entityId := range gs.entityGrid.QueryAABB(90, 10, 95, 15) {
+		if entityId == 4 {
+			t.Fatal("destroyed crate should be removed from entity grid")
+		}
+	}
+
+	mapEntities := gs.PrevGameStates[gs.cfg.GameStatesSaved-1].MapEntities
+	if len(mapEntities) != 2 {
+		t.Fatalf("expected door and crate to be present in game state, got: %v", len(mapEntities))
+	}
+	if mapEntities[1].EntityId != 4 || !mapEntities[1].Destroyed {
+		t.Fatalf("expected destroyed crate in game state, got: %v", mapEntities[1])
+	}
+}
+
+func TestEntityHitStopsAtNearestEntity(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	_, entities, err := NewMapEntities([]PolygonJSON{{Vertexes: []float64{96, 10, 99, 10, 99, 15, 96, 15}, Type: "CRATE", Hp: 20}})
+	if err != nil {
+		t.Fatalf("unable to create crate: %v", err)
+	}
+	farCrate := entities[0]
+	farCrate.EntityInfo.EntityId = int32(len(gs.GameState.MapEntities))
+	gs.GameState.MapEntities = append(gs.GameState.MapEntities, farCrate)
+	gs.unmovableEntities[int(farCrate.EntityInfo.EntityId)] = farCrate.area
+	gs.entityGrid.InsertPolygon(int(farCrate.EntityInfo.EntityId), farCrate.area)
+	nearCrate := gs.GameState.MapEntities[4]
+	player := gs.GameState.Players[0]
+	gs.GameState.Players[1].PlayerInfo.Position = &pb.Vector{X: 70, Y: 30}
+	player.PlayerInfo.Position = &pb.Vector{X: 80, Y: 12}
+	player.PlayerInfo.Angle = 0
+	gs.DoSessionTick()
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if nearCrate.EntityInfo.Hp != 10 || farCrate.EntityInfo.Hp != 20 {
+		t.Fatalf("expected only nearest crate to be damaged, hp: %v, %v", nearCrate.EntityInfo.Hp, farCrate.EntityInfo.Hp)
+	}
+
+	nearCrate.EntityInfo.Hp = 0
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if nearCrate.EntityInfo.Destroyed || farCrate.EntityInfo.Hp != 20 {
+		t.Fatalf("indestructible entity should block the hit, hp: %v", farCrate.EntityInfo.Hp)
+	}
+}
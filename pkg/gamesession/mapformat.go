@@ -0,0 +1,252 @@
+package gamesession
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/rand"
+
+	"github.com/Tarliton/collision2d"
+)
+
+const (
+	MapFormatV1 = 1
+	MapFormatV2 = 2
+)
+
+const (
+	ShapePolygon   = "polygon"
+	ShapeCircle    = "circle"
+	ShapeRectangle = "rectangle"
+
+	RegionTerrain     = "terrain"
+	RegionConcealment = "concealment"
+	RegionHazard      = "hazard"
+)
+
+// circleSegments is amount of vertexes of polygon approximating circle shape
+const circleSegments = 16
+
+// spawnAttempts limits random points tried for every spawn placed in spawn zone
+const spawnAttempts = 100
+
+type MapMetadataJSON struct {
+	Name               string `json:"name,omitempty"`
+	Author             string `json:"author,omitempty"`
+	RecommendedPlayers int    `json:"recommended_players,omitempty"`
+}
+
+type ShapeJSON struct {
+	Type     string    `json:"type"`
+	Vertexes []float64 `json:"vertexes,omitempty"`
+	Center   []float64 `json:"center,omitempty"`
+	Radius   float64   `json:"radius,omitempty"`
+	Position []float64 `json:"position,omitempty"`
+	Size     []float64 `json:"size,omitempty"`
+}
+
+type MapObjectJSON struct {
+	Name  string    `json:"name,omitempty"`
+	Shape ShapeJSON `json:"shape"`
+	Type  string    `json:"type,omitempty"`
+	Hp    int32     `json:"hp,omitempty"`
+}
+
+type RegionPropertiesJSON struct {
+	SpeedModifier   float32 `json:"speed_modifier,omitempty"`
+	DamagePerSecond float32 `json:"damage_per_second,omitempty"`
+}
+
+type RegionJSON struct {
+	Name       string               `json:"name,omitempty"`
+	Kind       string               `json:"kind"`
+	Shape      ShapeJSON            `json:"shape"`
+	Properties RegionPropertiesJSON `json:"properties"`
+}
+
+type SpawnZoneJSON struct {
+	Name     string    `json:"name,omitempty"`
+	Shape    ShapeJSON `json:"shape"`
+	Capacity int       `json:"capacity,omitempty"`
+}
+
+type LootSpotJSON struct {
+	Name     string    `json:"name,omitempty"`
+	Position []float32 `json:"position"`
+	Table    string    `json:"table,omitempty"`
+}
+
+// MapDescriptionV2 is map format with typed shapes, it is converted to MapDescription on load
+type MapDescriptionV2 struct {
+	Version    int             `json:"version"`
+	Metadata   MapMetadataJSON `json:"metadata"`
+	Width      float32         `json:"width"`
+	Height     float32         `json:"height"`
+	Objects    []MapObjectJSON `json:"objects"`
+	Regions    []RegionJSON    `json:"regions"`
+	SpawnZones []SpawnZoneJSON `json:"spawn_zones"`
+	LootSpots  []LootSpotJSON  `json:"loot_spots"`
+	SafeZone   *SafeZoneJSON   `json:"safe_zone"`
+}
+
+// LoadMap reads map description file of any supported version, see ParseMap
+func LoadMap(filename string, playerRadius float32, seed int64) (*MapDescription, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening map file: %v", err)
+	}
+	return ParseMap(data, playerRadius, seed)
+}
+
+// ParseMap converts map description of any supported version to MapDescription,
+// seed and player radius are used to place players in spawn zones
+func ParseMap(data []byte, playerRadius float32, seed int64) (*MapDescription, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling map file: %v", err)
+	}
+	switch header.Version {
+	case 0, MapFormatV1:
+		var mapDesc MapDescription
+		if err := json.Unmarshal(data, &mapDesc); err != nil {
+			return nil, fmt.Errorf("Error unmarshalling map file: %v", err)
+		}
+		return &mapDesc, nil
+	case MapFormatV2:
+		var mapDescV2 MapDescriptionV2
+		if err := json.Unmarshal(data, &mapDescV2); err != nil {
+			return nil, fmt.Errorf("Error unmarshalling map file: %v", err)
+		}
+		return mapDescV2.convert(playerRadius, rand.New(rand.NewSource(seed)))
+	default:
+		return nil, fmt.Errorf("unsupported map version: %v", header.Version)
+	}
+}
+
+// ToVertexes returns counter-clockwise vertexes of the shape
+func (s *ShapeJSON) ToVertexes() ([]float64, error) {
+	switch s.Type {
+	case ShapePolygon:
+		return s.Vertexes, nil
+	case ShapeCircle:
+		if len(s.Center) != 2 || s.Radius <= 0 {
+			return nil, fmt.Errorf("circle should have center and positive radius")
+		}
+		vertexes := make([]float64, 0, circleSegments*2)
+		for i := 0; i < circleSegments; i++ {
+			angle := 2 * math.Pi * float64(i) / circleSegments
+			vertexes = append(vertexes, s.Center[0]+s.Radius*math.Cos(angle), s.Center[1]+s.Radius*math.Sin(angle))
+		}
+		return vertexes, nil
+	case ShapeRectangle:
+		if len(s.Position) != 2 || len(s.Size) != 2 || s.Size[0] <= 0 || s.Size[1] <= 0 {
+			return nil, fmt.Errorf("rectangle should have position and positive size")
+		}
+		x, y, width, height := s.Position[0], s.Position[1], s.Size[0], s.Size[1]
+		return []float64{x, y, x + width, y, x + width, y + height, x, y + height}, nil
+	default:
+		return nil, fmt.Errorf("unknown shape type: %v", s.Type)
+	}
+}
+
+func (m *MapDescriptionV2) convert(playerRadius float32, random *rand.Rand) (*MapDescription, error) {
+	mapDesc := &MapDescription{
+		Metadata:   m.Metadata,
+		MapBorderX: m.Width,
+		MapBorderY: m.Height,
+		SafeZone:   m.SafeZone,
+	}
+	for i, object := range m.Objects {
+		vertexes, err := object.Shape.ToVertexes()
+		if err != nil {
+			return nil, fmt.Errorf("object %v: %v", i, err)
+		}
+		mapDesc.Polygons = append(mapDesc.Polygons, PolygonJSON{Name: object.Name, Vertexes: vertexes, Type: object.Type, Hp: object.Hp})
+	}
+	for i, region := range m.Regions {
+		vertexes, err := region.Shape.ToVertexes()
+		if err != nil {
+			return nil, fmt.Errorf("region %v: %v", i, err)
+		}
+		switch region.Kind {
+		case RegionTerrain:
+			mapDesc.Terrain = append(mapDesc.Terrain, TerrainJSON{Name: region.Name, Vertexes: vertexes, SpeedModifier: region.Properties.SpeedModifier})
+		case RegionConcealment:
+			mapDesc.Concealment = append(mapDesc.Concealment, ConcealmentJSON{Name: region.Name, Vertexes: vertexes})
+		case RegionHazard:
+			mapDesc.Hazards = append(mapDesc.Hazards, HazardJSON{Name: region.Name, Vertexes: vertexes, DamagePerSecond: region.Properties.DamagePerSecond})
+		default:
+			return nil, fmt.Errorf("region %v: unknown kind: %v", i, region.Kind)
+		}
+	}
+	hasTables := false
+	for i, spot := range m.LootSpots {
+		if len(spot.Position) != 2 {
+			return nil, fmt.Errorf("loot spot %v should have position", i)
+		}
+		mapDesc.LootSpawns = append(mapDesc.LootSpawns, spot.Position...)
+		mapDesc.LootSpotTables = append(mapDesc.LootSpotTables, spot.Table)
+		mapDesc.LootSpotNames = append(mapDesc.LootSpotNames, spot.Name)
+		hasTables = hasTables || spot.Table != ""
+	}
+	if !hasTables {
+		mapDesc.LootSpotTables = nil
+	}
+
+	obstacles := make([]collision2d.Polygon, 0, len(mapDesc.Polygons))
+	for _, polygon := range mapDesc.Polygons {
+		obstacles = append(obstacles, collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, polygon.Vertexes))
+	}
+	for i, zone := range m.SpawnZones {
+		vertexes, err := zone.Shape.ToVertexes()
+		if err != nil {
+			return nil, fmt.Errorf("spawn zone %v: %v", i, err)
+		}
+		capacity := zone.Capacity
+		if capacity <= 0 {
+			capacity = 1
+		}
+		for j := 0; j < capacity; j++ {
+			x, y, ok := mapDesc.placeSpawn(vertexes, obstacles, playerRadius, random)
+			if !ok {
+				return nil, fmt.Errorf("unable to place %v players in spawn zone %v", capacity, i)
+			}
+			mapDesc.PlayerSpawns = append(mapDesc.PlayerSpawns, x, y)
+		}
+	}
+	return mapDesc, nil
+}
+
+// placeSpawn picks random point of spawn zone inside map borders where player does not overlap obstacles and other spawns
+func (m *MapDescription) placeSpawn(vertexes []float64, obstacles []collision2d.Polygon, playerRadius float32, random *rand.Rand) (float32, float32, bool) {
+	area := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, vertexes)
+	minX, minY, maxX, maxY := polygonBounds(area)
+	for attempt := 0; attempt < spawnAttempts; attempt++ {
+		x := minX + random.Float32()*(maxX-minX)
+		y := minY + random.Float32()*(maxY-minY)
+		if x < playerRadius || y < playerRadius || x > m.MapBorderX-playerRadius || y > m.MapBorderY-playerRadius {
+			continue
+		}
+		if !collision2d.PointInPolygon(collision2d.NewVector(float64(x), float64(y)), area) {
+			continue
+		}
+		free := true
+		playerBody := squareBody(x, y, playerRadius)
+		for _, obstacle := range obstacles {
+			if _, info := collision2d.TestPolygonPolygon(playerBody, obstacle); info.Overlap >= 0 {
+				free = false
+				break
+			}
+		}
+		for i := 0; free && i+1 < len(m.PlayerSpawns); i += 2 {
+			free = CalculateDistance(x, y, m.PlayerSpawns[i], m.PlayerSpawns[i+1]) >= 2*playerRadius
+		}
+		if free {
+			return x, y, true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,135 @@
+package gamesession
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestParseMap(t *testing.T) {
+	absPath, _ := filepath.Abs("")
+	testDir := filepath.Join(absPath[:len(absPath)-16], "/test")
+	mapV1, err := LoadMap(filepath.Join(testDir, "testmap.json"), 5, 1)
+	if err != nil {
+		t.Fatalf("unable to load v1 map: %v", err)
+	}
+	mapV2, err := LoadMap(filepath.Join(testDir, "testmap_v2.json"), 5, 1)
+	if err != nil {
+		t.Fatalf("unable to load v2 map: %v", err)
+	}
+	if err = ValidateMap(mapV2, 5); err != nil {
+		t.Fatalf("v2 test map should be valid, got: %v", err)
+	}
+
+	if mapV2.Metadata.Name != "Test Valley" || mapV2.Metadata.RecommendedPlayers != 2 {
+		t.Fatalf("unexpected map metadata: %+v", mapV2.Metadata)
+	}
+	if mapV2.MapBorderX != mapV1.MapBorderX || mapV2.MapBorderY != mapV1.MapBorderY {
+		t.Fatalf("expected borders %vx%v, got %vx%v", mapV1.MapBorderX, mapV1.MapBorderY, mapV2.MapBorderX, mapV2.MapBorderY)
+	}
+	if len(mapV2.Polygons) != 5 || len(mapV2.Polygons[1].Vertexes) != circleSegments*2 || mapV2.Polygons[4].Type != "CRATE" {
+		t.Fatalf("unexpected map objects: %+v", mapV2.Polygons)
+	}
+	if !reflect.DeepEqual(mapV2.Polygons[3].Vertexes, mapV1.Polygons[3].Vertexes) {
+		t.Fatalf("expected rectangle %v, got %v", mapV1.Polygons[3].Vertexes, mapV2.Polygons[3].Vertexes)
+	}
+	if len(mapV2.Terrain) != 1 || mapV2.Terrain[0].SpeedModifier != 0.5 || len(mapV2.Concealment) != 1 ||
+		len(mapV2.Hazards) != 1 || mapV2.Hazards[0].Name != "campfire" || mapV2.Hazards[0].DamagePerSecond != 10 {
+		t.Fatalf("unexpected map regions: %+v, %+v, %+v", mapV2.Terrain, mapV2.Concealment, mapV2.Hazards)
+	}
+	if !reflect.DeepEqual(mapV2.LootSpawns, mapV1.LootSpawns) ||
+		!reflect.DeepEqual(mapV2.LootSpotTables, []string{"", "high_tier", "", ""}) || mapV2.LootSpotNames[3] != "river" {
+		t.Fatalf("unexpected loot spots: %v, %v, %v", mapV2.LootSpawns, mapV2.LootSpotTables, mapV2.LootSpotNames)
+	}
+
+	if len(mapV2.PlayerSpawns) != 4 {
+		t.Fatalf("expected spawn in every spawn zone, got: %v", mapV2.PlayerSpawns)
+	}
+	for i, zone := range [][4]float32{{0, 0, 20, 20}, {80, 80, 100, 100}} {
+		x, y := mapV2.PlayerSpawns[i*2], mapV2.PlayerSpawns[i*2+1]
+		if x < zone[0] || y < zone[1] || x > zone[2] || y > zone[3] {
+			t.Fatalf("spawn %v (%v, %v) is outside its zone %v", i, x, y, zone)
+		}
+	}
+	sameSeedMap, err := LoadMap(filepath.Join(testDir, "testmap_v2.json"), 5, 1)
+	if err != nil {
+		t.Fatalf("unable to load v2 map: %v", err)
+	}
+	if !reflect.DeepEqual(sameSeedMap.PlayerSpawns, mapV2.PlayerSpawns) {
+		t.Fatalf("same seed should place same spawns, got %v and %v", sameSeedMap.PlayerSpawns, mapV2.PlayerSpawns)
+	}
+
+	for _, data := range []string{
+		`{"version": 3}`,
+		`{"version": 2, "width": 100, "height": 100, "objects": [{"shape": {"type": "star"}}]}`,
+		`{"version": 2, "width": 100, "height": 100, "objects": [{"shape": {"type": "circle", "center": [5, 5]}}]}`,
+		`{"version": 2, "width": 100, "height": 100, "regions": [{"kind": "lava", "shape": {"type": "polygon", "vertexes": [0, 0, 1, 0, 1, 1]}}]}`,
+		`{"version": 2, "width": 100, "height": 100, "spawn_zones": [{"shape": {"type": "rectangle", "position": [0, 0], "size": [4, 4]}}]}`,
+	} {
+		if _, err = ParseMap([]byte(data), 5, 1); err == nil {
+			t.Fatalf("expected error for map %v", data)
+		}
+	}
+}
+
+func TestGameSessionFromMapV2(t *testing.T) {
+	absPath, _ := filepath.Abs("")
+	testDir := filepath.Join(absPath[:len(absPath)-16], "/test")
+	gs, err := NewGameSession(&GameSessionConfig{
+		GameStatesSaved:     3,
+		GameStatesShiftBack: 1,
+		TicksPerSecond:      30,
+		PlayerCount:         2,
+		PlayerRadius:        5,
+		DefaultWeapon:       &pb.EquipmentItem{Type: pb.EquipmentItemType_WEAPON, Characteristics: &pb.EquipmentItem_WeaponChars{WeaponChars: &pb.WeaponCharacteristics{}}},
+		LootTablesFile:      filepath.Join(testDir, "loottables.json"),
+		LootSeed:            7,
+	}, filepath.Join(testDir, "testmap_v2.json"))
+	if err != nil {
+		t.Fatalf("unable to create game session from v2 map: %v", err)
+	}
+	for i, player := range gs.GameState.Players {
+		if player.PlayerInfo.Position.X != gs.MapDesc.PlayerSpawns[i*2] || player.PlayerInfo.Position.Y != gs.MapDesc.PlayerSpawns[i*2+1] {
+			t.Fatalf("player %v should be placed at spawn, got %v", i, player.PlayerInfo.Position)
+		}
+	}
+	if len(gs.hazards) != 1 || len(gs.concealments) != 1 || len(gs.GameState.Items) != 4 {
+		t.Fatalf("expected map regions and loot to be loaded, got %v hazards, %v concealments, %v items",
+			len(gs.hazards), len(gs.concealments), len(gs.GameState.Items))
+	}
+}
+
+func TestHazards(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	gs.hazards = NewHazards([]HazardJSON{{Vertexes: []float64{45, 35, 55, 35, 55, 45, 45, 45}, DamagePerSecond: 15}}, gs.cfg.TicksPerSecond)
+
+	for i := 0; i < 10; i++ {
+		gs.DoSessionTick()
+	}
+	if player.PlayerInfo.Hp != 95 {
+		t.Fatalf("expected player inside hazard to lose 5 hp, got: %v", player.PlayerInfo.Hp)
+	}
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("expected player outside hazard to keep hp, got: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.PlayerInfo.Hp = 1
+	for i := 0; i < 10; i++ {
+		gs.DoSessionTick()
+	}
+	select {
+	case kill := <-gs.KillNotifications:
+		if kill.Actor != HazardKillActor || kill.Receiver != player.PlayerInfo.Nickname {
+			t.Fatalf("unexpected kill in hazard: %v", kill)
+		}
+	default:
+		t.Fatal("expected player to be killed by hazard")
+	}
+}
@@ -0,0 +1,254 @@
+package gamesession
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// MapValidationError lists every problem found in map description
+type MapValidationError struct {
+	Problems []string
+}
+
+func (e *MapValidationError) Error() string {
+	return "invalid map: " + strings.Join(e.Problems, "; ")
+}
+
+type mapValidator struct {
+	mapDesc      *MapDescription
+	playerRadius float32
+	problems     []string
+}
+
+// ValidateMap checks that map description can be used by game session, player radius is used to check that
+// spawns are free and connected, all found problems are returned as MapValidationError
+func ValidateMap(mapDesc *MapDescription, playerRadius float32) error {
+	if playerRadius <= 0 {
+		playerRadius = 1
+	}
+	v := &mapValidator{mapDesc: mapDesc, playerRadius: playerRadius}
+	if mapDesc.MapBorderX <= 0 || mapDesc.MapBorderY <= 0 {
+		v.addProblem("map borders should be positive, got %vx%v", mapDesc.MapBorderX, mapDesc.MapBorderY)
+		return v.err()
+	}
+
+	obstacles := make(map[int]collision2d.Polygon, len(mapDesc.Polygons))
+	for i, polygon := range mapDesc.Polygons {
+		name := fmt.Sprintf("entity %v", i)
+		if polygon.Type != "" {
+			if _, ok := pb.MapEntityType_value[polygon.Type]; !ok {
+				v.addProblem("%v has unknown type %v", name, polygon.Type)
+			}
+		}
+		if v.checkPolygon(name, polygon.Vertexes) {
+			obstacles[i] = collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, polygon.Vertexes)
+		}
+	}
+	for i, terrain := range mapDesc.Terrain {
+		v.checkPolygon(fmt.Sprintf("terrain %v", i), terrain.Vertexes)
+	}
+	for i, concealment := range mapDesc.Concealment {
+		v.checkPolygon(fmt.Sprintf("concealment %v", i), concealment.Vertexes)
+	}
+	for i, hazard := range mapDesc.Hazards {
+		v.checkPolygon(fmt.Sprintf("hazard %v", i), hazard.Vertexes)
+	}
+
+	entityGrid := NewEntityGrid(obstacles, playerRadius)
+	lootSpots := v.checkPoints("loot_spots", mapDesc.LootSpawns)
+	if len(mapDesc.LootSpotTables) != 0 && len(mapDesc.LootSpotTables) != len(lootSpots) {
+		v.addProblem("loot_spot_tables should name table of every loot spot, got %v tables for %v spots", len(mapDesc.LootSpotTables), len(lootSpots))
+	}
+	if len(mapDesc.LootSpotNames) != 0 && len(mapDesc.LootSpotNames) != len(lootSpots) {
+		v.addProblem("loot_spot_names should name every loot spot, got %v names for %v spots", len(mapDesc.LootSpotNames), len(lootSpots))
+	}
+	for i, spot := range lootSpots {
+		if !v.insideBorders(spot) {
+			v.addProblem("loot spot %v %v is outside map borders", i, spot)
+			continue
+		}
+		point := collision2d.NewVector(float64(spot[0]), float64(spot[1]))
+		for _, entityId := range entityGrid.QueryRadius(spot[0], spot[1], 0) {
+			if collision2d.PointInPolygon(point, obstacles[entityId]) {
+				v.addProblem("loot spot %v %v is inside entity %v", i, spot, entityId)
+				break
+			}
+		}
+	}
+
+	spawns := v.checkPoints("player_spawns", mapDesc.PlayerSpawns)
+	freeSpawns := make([]int, 0, len(spawns))
+	for i, spawn := range spawns {
+		if !v.insideBorders(spawn) {
+			v.addProblem("player spawn %v %v is outside map borders", i, spawn)
+			continue
+		}
+		if entityId := v.collidingEntity(entityGrid, obstacles, spawn[0], spawn[1]); entityId >= 0 {
+			v.addProblem("player spawn %v %v overlaps entity %v", i, spawn, entityId)
+			continue
+		}
+		freeSpawns = append(freeSpawns, i)
+	}
+	v.checkReachability(entityGrid, obstacles, spawns, freeSpawns)
+	return v.err()
+}
+
+func (v *mapValidator) addProblem(format string, args ...interface{}) {
+	v.problems = append(v.problems, fmt.Sprintf(format, args...))
+}
+
+func (v *mapValidator) err() error {
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return &MapValidationError{Problems: v.problems}
+}
+
+// checkPoints splits flat list of coordinates into points
+func (v *mapValidator) checkPoints(name string, coordinates []float32) [][2]float32 {
+	if len(coordinates)%2 != 0 {
+		v.addProblem("%v has odd number of coordinates: %v", name, len(coordinates))
+	}
+	points := make([][2]float32, 0, len(coordinates)/2)
+	for i := 0; i+1 < len(coordinates); i += 2 {
+		points = append(points, [2]float32{coordinates[i], coordinates[i+1]})
+	}
+	return points
+}
+
+// checkPolygon returns true if polygon is convex and its vertexes go counter-clockwise as collisions expect
+func (v *mapValidator) checkPolygon(name string, vertexes []float64) bool {
+	if len(vertexes)%2 != 0 {
+		v.addProblem("%v has odd number of coordinates: %v", name, len(vertexes))
+		return false
+	}
+	count := len(vertexes) / 2
+	if count < 3 {
+		v.addProblem("%v should have at least 3 vertexes, got %v", name, count)
+		return false
+	}
+	positive, negative := false, false
+	area := 0.0
+	for i := 0; i < count; i++ {
+		x1, y1 := vertexes[i*2], vertexes[i*2+1]
+		x2, y2 := vertexes[(i+1)%count*2], vertexes[(i+1)%count*2+1]
+		x3, y3 := vertexes[(i+2)%count*2], vertexes[(i+2)%count*2+1]
+		area += x1*y2 - x2*y1
+		turn := (x2-x1)*(y3-y2) - (y2-y1)*(x3-x2)
+		if turn > 0 {
+			positive = true
+		} else if turn < 0 {
+			negative = true
+		}
+	}
+	switch {
+	case math.Abs(area) < 1e-9:
+		v.addProblem("%v has zero area", name)
+		return false
+	case positive && negative:
+		v.addProblem("%v is not convex", name)
+		return false
+	case area < 0:
+		v.addProblem("%v vertexes should go counter-clockwise", name)
+		return false
+	}
+	return true
+}
+
+func (v *mapValidator) insideBorders(point [2]float32) bool {
+	return point[0] >= 0 && point[0] <= v.mapDesc.MapBorderX && point[1] >= 0 && point[1] <= v.mapDesc.MapBorderY
+}
+
+// collidingEntity returns id of obstacle overlapping player body at the position or -1
+func (v *mapValidator) collidingEntity(entityGrid *Grid, obstacles map[int]collision2d.Polygon, x, y float32) int {
+	playerBody := squareBody(x, y, v.playerRadius)
+	for _, entityId := range entityGrid.QueryRadius(x, y, v.playerRadius) {
+		if _, info := collision2d.TestPolygonPolygon(playerBody, obstacles[entityId]); info.Overlap >= 0 {
+			return entityId
+		}
+	}
+	return -1
+}
+
+// passableObstacles returns entities players can get through during the game, like doors and destructible entities
+func passableObstacles(mapDesc *MapDescription, obstacles map[int]collision2d.Polygon) map[int]bool {
+	passable := make(map[int]bool)
+	for entityId := range obstacles {
+		polygon := mapDesc.Polygons[entityId]
+		if polygon.Type == pb.MapEntityType_DOOR.String() || polygon.Hp > 0 {
+			passable[entityId] = true
+		}
+	}
+	return passable
+}
+
+// checkReachability flood fills grid of player positions with cell of half player radius from the first free spawn
+func (v *mapValidator) checkReachability(entityGrid *Grid, obstacles map[int]collision2d.Polygon, spawns [][2]float32, freeSpawns []int) {
+	if len(freeSpawns) < 2 {
+		return
+	}
+	passable := passableObstacles(v.mapDesc, obstacles)
+	cellSize := v.playerRadius / 2
+	cols := int(math.Ceil(float64(v.mapDesc.MapBorderX / cellSize)))
+	rows := int(math.Ceil(float64(v.mapDesc.MapBorderY / cellSize)))
+	free := func(col, row int) bool {
+		if col < 0 || row < 0 || col >= cols || row >= rows {
+			return false
+		}
+		x, y := (float32(col)+0.5)*cellSize, (float32(row)+0.5)*cellSize
+		playerBody := squareBody(x, y, v.playerRadius)
+		for _, entityId := range entityGrid.QueryRadius(x, y, v.playerRadius) {
+			if passable[entityId] {
+				continue
+			}
+			if _, info := collision2d.TestPolygonPolygon(playerBody, obstacles[entityId]); info.Overlap >= 0 {
+				return false
+			}
+		}
+		return true
+	}
+	// spawnCells returns free cells whose centers surround the spawn
+	spawnCells := func(spawn [2]float32) [][2]int {
+		col, row := int(math.Floor(float64(spawn[0]/cellSize-0.5))), int(math.Floor(float64(spawn[1]/cellSize-0.5)))
+		cells := make([][2]int, 0, 4)
+		for _, cell := range [][2]int{{col, row}, {col + 1, row}, {col, row + 1}, {col + 1, row + 1}} {
+			if free(cell[0], cell[1]) {
+				cells = append(cells, cell)
+			}
+		}
+		return cells
+	}
+
+	reached := make([]bool, cols*rows)
+	queue := spawnCells(spawns[freeSpawns[0]])
+	for _, cell := range queue {
+		reached[cell[1]*cols+cell[0]] = true
+	}
+	for len(queue) > 0 {
+		cell := queue[0]
+		queue = queue[1:]
+		for _, next := range [][2]int{{cell[0] + 1, cell[1]}, {cell[0] - 1, cell[1]}, {cell[0], cell[1] + 1}, {cell[0], cell[1] - 1}} {
+			if !free(next[0], next[1]) || reached[next[1]*cols+next[0]] {
+				continue
+			}
+			reached[next[1]*cols+next[0]] = true
+			queue = append(queue, next)
+		}
+	}
+	for _, spawnId := range freeSpawns[1:] {
+		connected := false
+		for _, cell := range spawnCells(spawns[spawnId]) {
+			if reached[cell[1]*cols+cell[0]] {
+				connected = true
+				break
+			}
+		}
+		if !connected {
+			v.addProblem("player spawn %v is not reachable from player spawn %v", spawnId, freeSpawns[0])
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package gamesession
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateMap(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	if err = ValidateMap(&gs.MapDesc, 5); err != nil {
+		t.Fatalf("test map should be valid, got: %v", err)
+	}
+
+	wall := PolygonJSON{Vertexes: []float64{48, 0, 52, 0, 52, 100, 48, 100}}
+	door := PolygonJSON{Vertexes: []float64{48, 0, 52, 0, 52, 100, 48, 100}, Type: "DOOR"}
+	tests := []struct {
+		name    string
+		mapDesc MapDescription
+		problem string
+	}{
+		{
+			name:    "odd loot spots",
+			mapDesc: MapDescription{LootSpawns: []float32{10, 10, 20}},
+			problem: "loot_spots has odd number of coordinates",
+		},
+		{
+			name:    "loot outside borders",
+			mapDesc: MapDescription{LootSpawns: []float32{10, 110}},
+			problem: "loot spot 0 [10 110] is outside map borders",
+		},
+		{
+			name:    "loot inside entity",
+			mapDesc: MapDescription{Polygons: []PolygonJSON{wall}, LootSpawns: []float32{50, 50}},
+			problem: "loot spot 0 [50 50] is inside entity 0",
+		},
+		{
+			name:    "clockwise polygon",
+			mapDesc: MapDescription{Polygons: []PolygonJSON{{Vertexes: []float64{0, 0, 0, 10, 10, 10, 10, 0}}}},
+			problem: "entity 0 vertexes should go counter-clockwise",
+		},
+		{
+			name:    "concave polygon",
+			mapDesc: MapDescription{Terrain: []TerrainJSON{{Vertexes: []float64{0, 0, 10, 0, 5, 2, 10, 10, 0, 10}}}},
+			problem: "terrain 0 is not convex",
+		},
+		{
+			name:    "degenerate polygon",
+			mapDesc: MapDescription{Concealment: []ConcealmentJSON{{Vertexes: []float64{0, 0, 5, 5, 10, 10}}}},
+			problem: "concealment 0 has zero area",
+		},
+		{
+			name:    "spawn inside entity",
+			mapDesc: MapDescription{Polygons: []PolygonJSON{wall}, PlayerSpawns: []float32{47, 50}},
+			problem: "player spawn 0 [47 50] overlaps entity 0",
+		},
+		{
+			name:    "unreachable spawn",
+			mapDesc: MapDescription{Polygons: []PolygonJSON{wall}, PlayerSpawns: []float32{10, 10, 90, 90}},
+			problem: "player spawn 1 is not reachable from player spawn 0",
+		},
+		{
+			name:    "spawns connected by door",
+			mapDesc: MapDescription{Polygons: []PolygonJSON{door}, PlayerSpawns: []float32{10, 10, 90, 90}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mapDesc.MapBorderX = 100
+			tt.mapDesc.MapBorderY = 100
+			err := ValidateMap(&tt.mapDesc, 5)
+			if tt.problem == "" {
+				if err != nil {
+					t.Fatalf("expected map to be valid, got: %v", err)
+				}
+				return
+			}
+			var validationErr *MapValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("expected validation error, got: %v", err)
+			}
+			if len(validationErr.Problems) != 1 || !strings.Contains(validationErr.Problems[0], tt.problem) {
+				t.Fatalf("expected problem %q, got: %v", tt.problem, validationErr.Problems)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package gamesession
+
+import (
+	"math"
+
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+type TerrainJSON struct {
+	Name          string    `json:"name,omitempty"`
+	Vertexes      []float64 `json:"vertexes"`
+	SpeedModifier float32   `json:"speed_modifier"`
+}
+
+type Terrain struct {
+	area          collision2d.Polygon
+	speedModifier float32
+}
+
+func NewTerrain(terrainDescs []TerrainJSON) []Terrain {
+	terrain := make([]Terrain, 0, len(terrainDescs))
+	for _, desc := range terrainDescs {
+		terrain = append(terrain, Terrain{
+			area:          collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, desc.Vertexes),
+			speedModifier: desc.SpeedModifier,
+		})
+	}
+	return terrain
+}
+
+func (g *GameSession) terrainSpeedModifier(position *pb.Vector) float32 {
+	point := collision2d.NewVector(float64(position.X), float64(position.Y))
+	for _, terrain := range g.terrain {
+		if collision2d.PointInPolygon(point, terrain.area) {
+			return terrain.speedModifier
+		}
+	}
+	return 1
+}
+
+func (g *GameSession) maxPlayerSpeed(player *SyncPlayer, sprint bool) float32 {
+	speed := g.cfg.PlayerMaxSpeed * g.terrainSpeedModifier(player.PlayerInfo.Position) * player.speedMultiplier()
+	if sprint && g.cfg.PlayerSprintMultiplier > 0 {
+		speed *= g.cfg.PlayerSprintMultiplier
+	}
+	return speed
+}
+
+// setMovementIntent stores normalized direction of player, which is integrated by session tick
+func setMovementIntent(player *SyncPlayer, moveAction *pb.MovementAction) {
+	dirX, dirY := moveAction.Direction.X, moveAction.Direction.Y
+	length := float32(math.Sqrt(float64(dirX*dirX + dirY*dirY)))
+	if length > 1 {
+		dirX /= length
+		dirY /= length
+	}
+	player.moveDirX = dirX
+	player.moveDirY = dirY
+	player.sprint = moveAction.Sprint
+	player.intentMovement = true
+}
+
+// clampShift limits legacy shift of player to the distance reachable since the last tick
+func (g *GameSession) clampShift(player *SyncPlayer, shift *pb.Vector) *pb.Vector {
+	if shift == nil || g.cfg.PlayerMaxSpeed <= 0 {
+		return shift
+	}
+	length := float32(math.Sqrt(float64(shift.X*shift.X + shift.Y*shift.Y)))
+	if length <= player.shiftBudget {
+		player.shiftBudget -= length
+		return shift
+	}
+	scale := player.shiftBudget / length
+	player.shiftBudget = 0
+	return &pb.Vector{X: shift.X * scale, Y: shift.Y * scale}
+}
+
+func (g *GameSession) integrateMovement(player *SyncPlayer) {
+	if g.cfg.PlayerMaxSpeed <= 0 {
+		return
+	}
+	player.shiftBudget = g.maxPlayerSpeed(player, true) / float32(g.cfg.TicksPerSecond)
+	if !player.intentMovement {
+		return
+	}
+	if player.PlayerInfo.Velocity == nil {
+		player.PlayerInfo.Velocity = &pb.Vector{}
+	}
+	velocity := player.PlayerInfo.Velocity
+	maxSpeed := g.maxPlayerSpeed(player, player.sprint)
+	deltaX := player.moveDirX*maxSpeed - velocity.X
+	deltaY := player.moveDirY*maxSpeed - velocity.Y
+	if g.cfg.PlayerAcceleration > 0 {
+		maxDelta := g.cfg.PlayerAcceleration / float32(g.cfg.TicksPerSecond)
+		delta := float32(math.Sqrt(float64(deltaX*deltaX + deltaY*deltaY)))
+		if delta > maxDelta {
+			deltaX *= maxDelta / delta
+			deltaY *= maxDelta / delta
+		}
+	}
+	velocity.X += deltaX
+	velocity.Y += deltaY
+
+	position := player.PlayerInfo.Position
+	position.X += velocity.X / float32(g.cfg.TicksPerSecond)
+	position.Y += velocity.Y / float32(g.cfg.TicksPerSecond)
+	if position.X < 0 || position.X > g.mapBorderX {
+		position.X = float32(math.Max(0, math.Min(float64(position.X), float64(g.mapBorderX))))
+		velocity.X = 0
+	}
+	if position.Y < 0 || position.Y > g.mapBorderY {
+		position.Y = float32(math.Max(0, math.Min(float64(position.Y), float64(g.mapBorderY))))
+		velocity.Y = 0
+	}
+}
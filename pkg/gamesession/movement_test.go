@@ -0,0 +1,77 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestVelocityMovement(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.PlayerMaxSpeed = 30
+	gs.cfg.PlayerSprintMultiplier = 1.5
+	gs.cfg.PlayerAcceleration = 300
+	player := gs.GameState.Players[0]
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{X: 1, Y: 0}}}}, 0)
+	if player.PlayerInfo.Position.X != 50 {
+		t.Fatal("movement intent should not move player before session tick")
+	}
+	for i := 0; i < 3; i++ {
+		gs.DoSessionTick()
+	}
+	checkPlayerMovement(t, player, 52, 40, 30)
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 53, 40, 30)
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{X: 3, Y: 4}, Sprint: true}}}, 0)
+	for i := 0; i < 5; i++ {
+		gs.DoSessionTick()
+	}
+	if speed := CalculateDistance(0, 0, player.PlayerInfo.Velocity.X, player.PlayerInfo.Velocity.Y); math.Abs(float64(speed-45)) > 0.01 {
+		t.Fatalf("expected sprinting player to reach speed 45, got: %.3f", speed)
+	}
+
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{}}}}, 0)
+	for i := 0; i < 5; i++ {
+		gs.DoSessionTick()
+	}
+	if player.PlayerInfo.Velocity.X != 0 || player.PlayerInfo.Velocity.Y != 0 {
+		t.Fatalf("expected player to stop, velocity: %v", player.PlayerInfo.Velocity)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 45, Y: 10}
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{X: 1, Y: 0}}}}, 0)
+	for i := 0; i < 5; i++ {
+		gs.DoSessionTick()
+	}
+	if math.Abs(float64(player.PlayerInfo.Velocity.X-15)) > 0.01 {
+		t.Fatalf("expected terrain to slow player down to 15, got: %.3f", player.PlayerInfo.Velocity.X)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 40}
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 10, Y: 0}}}}, 0)
+	checkPlayerMovement(t, player, 50.75, 40, 0)
+	gs.DoSessionTick()
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 0, Y: 1}}}}, 0)
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Shift: &pb.Vector{X: 0, Y: 1}}}}, 0)
+	checkPlayerMovement(t, player, 50.75, 41.5, 0)
+}
+
+func checkPlayerMovement(t *testing.T, player *SyncPlayer, x, y, speed float32) {
+	position := player.PlayerInfo.Position
+	if math.Abs(float64(position.X-x)) > 0.01 || math.Abs(float64(position.Y-y)) > 0.01 {
+		t.Fatalf("expected player at (%.3f, %.3f), got: (%.3f, %.3f)", x, y, position.X, position.Y)
+	}
+	var actualSpeed float32
+	if velocity := player.PlayerInfo.Velocity; velocity != nil {
+		actualSpeed = CalculateDistance(0, 0, velocity.X, velocity.Y)
+	}
+	if math.Abs(float64(actualSpeed-speed)) > 0.01 {
+		t.Fatalf("expected player speed %.3f, got: %.3f", speed, actualSpeed)
+	}
+}
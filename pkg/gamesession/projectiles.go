@@ -0,0 +1,142 @@
+package gamesession
+
+import (
+	"math"
+
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+type SyncProjectile struct {
+	ProjectileInfo *pb.Projectile
+	attackPower    int32
+	wallSlamDamage int32
+	knockbackPower float32
+	onHitEffects   []*pb.StatusEffectDefinition
+	speed          float32
+	distanceLeft   float32
+}
+
+func isRangedWeapon(weapon *pb.EquipmentItem) bool {
+	return weapon.GetWeaponChars().GetProjectileSpeed() > 0
+}
+
+func (g *GameSession) processRangedAttack(player *pb.Player, playerId int32) {
+	playerR := g.GameState.Players[int(playerId)]
+	weaponChars := playerR.PlayerInfo.Equipment.Weapon.GetWeaponChars()
+	if weaponChars.GetProjectileSpeed() <= 0 || weaponChars.GetAmmo() <= 0 {
+		return
+	}
+	weaponChars.Ammo--
+	projectile := &SyncProjectile{
+		ProjectileInfo: &pb.Projectile{
+			OwnerId:  playerId,
+			Position: player.Position.Deepcopy(),
+			Angle:    player.Angle,
+		},
+		attackPower:    weaponChars.AttackPower,
+		wallSlamDamage: weaponChars.WallSlamDamage,
+		knockbackPower: weaponChars.KnockbackPower,
+		onHitEffects:   weaponChars.OnHitEffects,
+		speed:          weaponChars.ProjectileSpeed,
+		distanceLeft:   weaponChars.ProjectileMaxDistance,
+	}
+
+	projectile.ProjectileInfo.ProjectileId = g.nextProjectileId
+	g.nextProjectileId++
+	g.GameState.Projectiles = append(g.GameState.Projectiles, projectile)
+
+	g.notifyAttack(playerId)
+}
+
+func (g *GameSession) moveProjectiles(playerGrid *Grid) {
+	projectiles := g.GameState.Projectiles[:0]
+	for _, projectile := range g.GameState.Projectiles {
+		if g.moveProjectile(projectile, playerGrid) {
+			projectiles = append(projectiles, projectile)
+		}
+	}
+	g.GameState.Projectiles = projectiles
+}
+
+// moveProjectile returns false when projectile has hit something or reached its max distance
+func (g *GameSession) moveProjectile(projectile *SyncProjectile, playerGrid *Grid) bool {
+	info := projectile.ProjectileInfo
+	step := projectile.speed / float32(g.cfg.TicksPerSecond)
+	if step > projectile.distanceLeft {
+		step = projectile.distanceLeft
+	}
+	if step <= 0 {
+		return false
+	}
+	projectile.distanceLeft -= step
+	startX, startY := info.Position.X, info.Position.Y
+	endX := startX + step*float32(math.Cos(float64(info.Angle)))
+	endY := startY + step*float32(math.Sin(float64(info.Angle)))
+
+	// players can only be hit on the part of the path before the nearest map entity
+	wallId, wallPart := -1, float32(1)
+	for _, entityId := range g.entityGrid.QuerySegment(startX, startY, endX, endY) {
+		if part, crossing := segmentPolygonEntry(startX, startY, endX, endY, g.unmovableEntities[entityId]); crossing && part < wallPart {
+			wallId, wallPart = entityId, part
+		}
+	}
+	if wallId >= 0 {
+		endX = startX + (endX-startX)*wallPart
+		endY = startY + (endY-startY)*wallPart
+	}
+	path := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, []float64{
+		float64(startX), float64(startY),
+		float64(endX), float64(endY),
+	})
+
+	var target *SyncPlayer
+	var targetDistance float32
+	minX, maxX := startX, endX
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	minY, maxY := startY, endY
+	if minY > maxY {
+		minY, maxY = maxY, minY
+	}
+	for _, playerId := range playerGrid.QueryAABB(minX, minY, maxX, maxY) {
+		player := g.GameState.Players[playerId]
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 || player.PlayerInfo.PlayerId == info.OwnerId {
+			continue
+		}
+		if !g.cfg.FriendlyFire && g.areTeammates(player.PlayerInfo.PlayerId, info.OwnerId) {
+			continue
+		}
+		playerBody := collision2d.NewCircle(collision2d.NewVector(float64(player.PlayerInfo.Position.X), float64(player.PlayerInfo.Position.Y)),
+			float64(g.cfg.PlayerRadius))
+		if colliding, _ := collision2d.TestPolygonCircle(path, playerBody); !colliding {
+			continue
+		}
+		distance := CalculateDistance(startX, startY, player.PlayerInfo.Position.X, player.PlayerInfo.Position.Y)
+		if target == nil || distance < targetDistance {
+			target = player
+			targetDistance = distance
+		}
+	}
+	if target != nil {
+		knockbackX := projectile.knockbackPower * float32(math.Cos(float64(info.Angle)))
+		knockbackY := projectile.knockbackPower * float32(math.Sin(float64(info.Angle)))
+		if g.hitPlayer(info.OwnerId, target.PlayerInfo.PlayerId, projectile.attackPower, projectile.wallSlamDamage, knockbackX, knockbackY, info.Angle, false) {
+			g.applyOnHitEffects(target, info.OwnerId, projectile.onHitEffects)
+		}
+		return false
+	}
+
+	if wallId >= 0 {
+		g.damageMapEntity(wallId, projectile.attackPower)
+		return false
+	}
+	if endX < 0 || endX > g.mapBorderX || endY < 0 || endY > g.mapBorderY {
+		return false
+	}
+
+	info.Position.X = endX
+	info.Position.Y = endY
+	return projectile.distanceLeft > 0
+}
@@ -0,0 +1,126 @@
+package gamesession
+
+import (
+	"math"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestRangedAttack(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	player.PlayerInfo.Equipment.Weapon = &pb.EquipmentItem{
+		Type:   pb.EquipmentItemType_WEAPON,
+		Rarity: pb.EquipmentItemRarity_EPIC,
+		Characteristics: &pb.EquipmentItem_WeaponChars{WeaponChars: &pb.WeaponCharacteristics{
+			AttackPower:           25,
+			KnockbackPower:        2,
+			ProjectileSpeed:       60,
+			ProjectileMaxDistance: 50,
+			Ammo:                  2,
+		}},
+	}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 60}
+	gs.DoSessionTick()
+
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if len(gs.GameState.Projectiles) != 1 {
+		t.Fatalf("expected projectile to be spawned, got: %v", len(gs.GameState.Projectiles))
+	}
+	if ammo := player.PlayerInfo.Equipment.Weapon.GetWeaponChars().Ammo; ammo != 1 {
+		t.Fatalf("expected ammo to be decreased to 1, got: %v", ammo)
+	}
+
+	for i := 0; i < 7; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("projectile hit player #1 too early, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if projectiles := gs.PrevGameStates[len(gs.PrevGameStates)-1].Projectiles; len(projectiles) != 1 || projectiles[0].Position.Y != 54 {
+		t.Fatalf("expected projectile in flight to be saved in previous game state, got: %v", projectiles)
+	}
+	gs.DoSessionTick()
+	if enemy.PlayerInfo.Hp != 75 {
+		t.Fatalf("projectile hit on player #1 not registered properly, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if enemy.PlayerInfo.Position.Y != 62 {
+		t.Fatalf("expected player #1 to be knocked back, Y: %.3f", enemy.PlayerInfo.Position.Y)
+	}
+	if player.PlayerInfo.Stats.Damage != 25 {
+		t.Fatalf("damage stats for player #0 not updated properly: %v", player.PlayerInfo.Stats.Damage)
+	}
+	if len(gs.GameState.Projectiles) != 0 {
+		t.Fatal("expected projectile to be removed after hit")
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 55}
+	player.PlayerInfo.Angle = math.Pi
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	for i := 0; i < 15; i++ {
+		gs.DoSessionTick()
+	}
+	if len(gs.GameState.Projectiles) != 0 {
+		t.Fatalf("expected projectile to be stopped by map entity, position: %v", gs.GameState.Projectiles[0].ProjectileInfo.Position)
+	}
+	if enemy.PlayerInfo.Hp != 75 {
+		t.Fatalf("projectile should not hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	stamina := player.PlayerInfo.Stamina
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if len(gs.GameState.Projectiles) != 0 {
+		t.Fatal("projectile should not be spawned without ammo")
+	}
+	if player.PlayerInfo.Stamina != stamina {
+		t.Fatalf("attack without ammo should not spend stamina, stamina: %.3f", player.PlayerInfo.Stamina)
+	}
+
+	player.PlayerInfo.Equipment.Weapon.GetWeaponChars().Ammo = 1
+	player.PlayerInfo.Angle = 3 * math.Pi / 2
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	for i := 0; i < 26; i++ {
+		gs.DoSessionTick()
+	}
+	if len(gs.GameState.Projectiles) != 0 {
+		t.Fatal("expected projectile to be removed after reaching its max distance")
+	}
+}
+
+func TestProjectileStoppedByWall(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	enemy := gs.GameState.Players[1]
+	shoot := func(fromX float32) {
+		projectile := &SyncProjectile{
+			ProjectileInfo: &pb.Projectile{OwnerId: 0, Position: &pb.Vector{X: fromX, Y: 55}},
+			attackPower:    25,
+			speed:          35 * float32(gs.cfg.TicksPerSecond),
+			distanceLeft:   50,
+		}
+		if gs.moveProjectile(projectile, indexPlayers(gs.GameState.Players, gs.cfg.PlayerRadius)) {
+			t.Fatal("expected projectile to be stopped within the tick")
+		}
+	}
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 94, Y: 55}
+	shoot(62)
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("projectile should not hit player #1 behind map entity, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 62, Y: 55}
+	shoot(40)
+	if enemy.PlayerInfo.Hp != 75 {
+		t.Fatalf("expected projectile to hit player #1 in front of map entity, hp: %v", enemy.PlayerInfo.Hp)
+	}
+}
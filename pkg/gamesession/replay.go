@@ -0,0 +1,90 @@
+package gamesession
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const ReplayVersion = 1
+
+// InitPrevGameStates fills saved game states with the current one, should be called before the first tick
+func (g *GameSession) InitPrevGameStates() {
+	prevGameStates := make([]PrevGameState, 0, g.cfg.GameStatesSaved)
+	for i := 0; i < g.cfg.GameStatesSaved; i++ {
+		prevGameState := g.GameState.GetPrevGameState(g.cfg.PlayerCount, g.cfg.PlayerRadius)
+		prevGameState.Tick = g.currentTick - (g.cfg.GameStatesSaved - 1 - i)
+		prevGameStates = append(prevGameStates, prevGameState)
+	}
+	g.PrevGameStates = prevGameStates
+}
+
+func (g *GameSession) CurrentTick() int {
+	return g.currentTick
+}
+
+// StateProto returns game state sent to clients, should be called under session lock
+func (g *GameSession) StateProto() *pb.GameState {
+	state := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack]
+	return &pb.GameState{
+		Players:      state.Players,
+		DroppedItems: state.Items,
+		PlayersLeft:  int32(state.PlayersLeft),
+		SafeZone:     state.SafeZone,
+		Projectiles:  state.Projectiles,
+		MapEntities:  state.MapEntities,
+		TeamsLeft:    int32(state.TeamsLeft),
+		TimeLeft:     state.TimeLeft,
+	}
+}
+
+// ReplayHeader describes everything besides players' inputs needed to simulate the match again
+func (g *GameSession) ReplayHeader(keyframeInterval int) (*pb.ReplayHeader, error) {
+	cfg := *g.cfg
+	cfg.LootTablesFile = ""
+	cfg.LootTables = g.lootTables
+	cfg.LootSeed = g.lootSeed
+	config, err := json.Marshal(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling session config: %v", err)
+	}
+	header := &pb.ReplayHeader{
+		Version:          ReplayVersion,
+		Map:              g.mapData,
+		Config:           config,
+		Seed:             g.lootSeed,
+		DefaultWeapon:    g.cfg.DefaultWeapon,
+		KeyframeInterval: int32(keyframeInterval),
+	}
+	for _, player := range g.GameState.Players {
+		header.Nicknames = append(header.Nicknames, player.PlayerInfo.Nickname)
+		header.UserIds = append(header.UserIds, player.PlayerInfo.UserId)
+	}
+	return header, nil
+}
+
+// NewGameSessionFromReplay creates session in the state it had before the first recorded tick
+func NewGameSessionFromReplay(header *pb.ReplayHeader) (*GameSession, error) {
+	if header.Version != ReplayVersion {
+		return nil, fmt.Errorf("unsupported replay version: %v", header.Version)
+	}
+	var cfg GameSessionConfig
+	if err := json.Unmarshal(header.Config, &cfg); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling session config: %v", err)
+	}
+	cfg.DefaultWeapon = header.DefaultWeapon
+	cfg.LootSeed = header.Seed
+	if len(header.Nicknames) != cfg.PlayerCount || len(header.UserIds) != cfg.PlayerCount {
+		return nil, fmt.Errorf("replay should contain info of every player")
+	}
+	gs, err := NewGameSessionFromMap(&cfg, header.Map)
+	if err != nil {
+		return nil, err
+	}
+	for i := range header.Nicknames {
+		gs.SetPlayerInfo(header.Nicknames[i], header.UserIds[i], int32(i))
+	}
+	gs.InitPrevGameStates()
+	return gs, nil
+}
@@ -0,0 +1,77 @@
+package gamesession
+
+import (
+	"github.com/Tarliton/collision2d"
+)
+
+// separatePlayers pushes apart overlapping alive players, playerGrid should contain positions before separation
+func (g *GameSession) separatePlayers(playerGrid *Grid) {
+	for _, player := range g.GameState.Players {
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 {
+			continue
+		}
+		position := player.PlayerInfo.Position
+		for _, otherId := range playerGrid.QueryRadius(position.X, position.Y, g.cfg.PlayerRadius) {
+			if otherId <= int(player.PlayerInfo.PlayerId) {
+				continue
+			}
+			g.separatePair(player, g.GameState.Players[otherId])
+		}
+	}
+}
+
+func (g *GameSession) separatePair(playerA, playerB *SyncPlayer) {
+	positionA := playerA.PlayerInfo.Position
+	positionB := playerB.PlayerInfo.Position
+	distance := CalculateDistance(positionA.X, positionA.Y, positionB.X, positionB.Y)
+	overlap := 2*g.cfg.PlayerRadius - distance
+	if overlap <= 0 {
+		return
+	}
+	normalX, normalY := float32(1), float32(0)
+	if distance > 0 {
+		normalX = (positionB.X - positionA.X) / distance
+		normalY = (positionB.Y - positionA.Y) / distance
+	}
+	movedA := g.tryShiftPlayer(playerA, -normalX*overlap/2, -normalY*overlap/2)
+	movedB := g.tryShiftPlayer(playerB, normalX*overlap/2, normalY*overlap/2)
+	if !movedA && movedB {
+		g.tryShiftPlayer(playerB, normalX*overlap/2, normalY*overlap/2)
+	}
+	if movedA && !movedB {
+		g.tryShiftPlayer(playerA, -normalX*overlap/2, -normalY*overlap/2)
+	}
+}
+
+// tryShiftPlayer moves player only when new position is inside map borders and not blocked by map entities
+func (g *GameSession) tryShiftPlayer(player *SyncPlayer, shiftX, shiftY float32) bool {
+	newX := player.PlayerInfo.Position.X + shiftX
+	newY := player.PlayerInfo.Position.Y + shiftY
+	if newX < 0 || newX > g.mapBorderX || newY < 0 || newY > g.mapBorderY {
+		return false
+	}
+	if g.collidesWithEntities(newX, newY) {
+		return false
+	}
+	player.PlayerInfo.Position.X = newX
+	player.PlayerInfo.Position.Y = newY
+	return true
+}
+
+func (g *GameSession) collidesWithEntities(x, y float32) bool {
+	for _, entityId := range g.entityGrid.QueryRadius(x, y, g.cfg.PlayerRadius) {
+		if circleOverlapsPolygon(x, y, g.cfg.PlayerRadius, g.unmovableEntities[entityId]) {
+			return true
+		}
+	}
+	return false
+}
+
+func squareBody(x, y, halfSize float32) collision2d.Polygon {
+	return collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, []float64{
+		float64(x + halfSize), float64(y - halfSize),
+		float64(x - halfSize), float64(y - halfSize),
+		float64(x - halfSize), float64(y + halfSize),
+		float64(x + halfSize), float64(y + halfSize),
+	})
+}
@@ -0,0 +1,71 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestPlayerSeparation(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.PlayerCollision = true
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+
+	enemy.PlayerInfo.Position = &pb.Vector{X: 56, Y: 40}
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 48, 40, 0)
+	checkPlayerMovement(t, enemy, 58, 40, 0)
+
+	prevState := gs.PrevGameStates[gs.cfg.GameStatesSaved-1]
+	if prevState.Players[1].Position.X != 58 {
+		t.Fatalf("expected saved game state to contain separated position, got: %.3f", prevState.Players[1].Position.X)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 40}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 40}
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 45, 40, 0)
+	checkPlayerMovement(t, enemy, 55, 40, 0)
+
+	player.PlayerInfo.Position = &pb.Vector{X: 36, Y: 60}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 42, Y: 60}
+	gs.DoSessionTick()
+	checkPlayerMovement(t, player, 36, 60, 0)
+	checkPlayerMovement(t, enemy, 46, 60, 0)
+
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 40}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 51}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected attack to hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	checkPlayerMovement(t, enemy, 50, 53, 0)
+}
+
+func TestCircleOverlapsPolygon(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	polygon := gs.unmovableEntities[0]
+	for name, tc := range map[string]struct {
+		x, y     float32
+		overlaps bool
+	}{
+		"near vertex":      {x: 34, y: 60, overlaps: true},
+		"touching vertex":  {x: 35, y: 60, overlaps: true},
+		"away from vertex": {x: 36, y: 60, overlaps: false},
+		"inside":           {x: 20, y: 65, overlaps: true},
+		"near edge":        {x: 6, y: 65, overlaps: true},
+		"beyond corner":    {x: 5, y: 44, overlaps: false},
+	} {
+		if overlaps := circleOverlapsPolygon(tc.x, tc.y, 5, polygon); overlaps != tc.overlaps {
+			t.Errorf("%v: expected overlap %v at (%v, %v), got %v", name, tc.overlaps, tc.x, tc.y, overlaps)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gamesession
+
+import (
+	"github.com/Tarliton/collision2d"
+)
+
+// hasLineOfSight returns false when any map entity crosses the segment between two points
+func (g *GameSession) hasLineOfSight(fromX, fromY, toX, toY float32) bool {
+	entityIds := g.entityGrid.QuerySegment(fromX, fromY, toX, toY)
+	if len(entityIds) == 0 {
+		return true
+	}
+
+	sight := collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, []float64{
+		float64(fromX), float64(fromY),
+		float64(toX), float64(toY),
+	})
+	for _, entityId := range entityIds {
+		if colliding, _ := collision2d.TestPolygonPolygon(sight, g.unmovableEntities[entityId]); colliding {
+			return false
+		}
+	}
+	return true
+}
+
+// segmentPolygonEntry returns part of the segment before it enters the polygon and false if they do not intersect
+func segmentPolygonEntry(fromX, fromY, toX, toY float32, polygon collision2d.Polygon) (float32, bool) {
+	if collision2d.PointInPolygon(collision2d.NewVector(float64(fromX), float64(fromY)), polygon) {
+		return 0, true
+	}
+	dx, dy := float64(toX-fromX), float64(toY-fromY)
+	entry, crossing := 1.0, false
+	points := polygon.CalcPoints
+	for i := range points {
+		ax, ay := polygon.Pos.X+points[i].X-float64(fromX), polygon.Pos.Y+points[i].Y-float64(fromY)
+		next := points[(i+1)%len(points)]
+		ex, ey := next.X-points[i].X, next.Y-points[i].Y
+		denominator := dx*ey - dy*ex
+		if denominator == 0 {
+			continue
+		}
+		part := (ax*ey - ay*ex) / denominator
+		edgePart := (ax*dy - ay*dx) / denominator
+		if part >= 0 && part <= entry && edgePart >= 0 && edgePart <= 1 {
+			entry, crossing = part, true
+		}
+	}
+	return float32(entry), crossing
+}
@@ -0,0 +1,51 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestLineOfSight(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	player.PlayerInfo.Equipment.Weapon.GetWeaponChars().Range = 20
+	player.PlayerInfo.Angle = 0
+
+	player.PlayerInfo.Position = &pb.Vector{X: 64, Y: 65}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 86, Y: 65}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 100 {
+		t.Fatalf("attack through map entity should not hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 64, Y: 30}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 82, Y: 30}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if enemy.PlayerInfo.Hp != 90 {
+		t.Fatalf("expected attack in line of sight to hit player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	item := gs.GameState.Items[1]
+	player.PlayerInfo.Position = &pb.Vector{X: 73, Y: 65}
+	item.ItemInfo.Position = &pb.Vector{X: 82, Y: 65}
+	gs.DoSessionTick()
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 1}}}, 0)
+	if item.pickedUp {
+		t.Fatal("item behind map entity should not be picked up")
+	}
+
+	player.PlayerInfo.Position = &pb.Vector{X: 73, Y: 75}
+	item.ItemInfo.Position = &pb.Vector{X: 82, Y: 75}
+	gs.DoSessionTick()
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_PickUp{PickUp: &pb.PickUpAction{ItemId: 1}}}, 0)
+	if !item.pickedUp {
+		t.Fatal("expected item in line of sight to be picked up")
+	}
+}
@@ -0,0 +1,215 @@
+package gamesession
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+type StatusEffectJSON struct {
+	Type          string  `json:"type"`
+	Duration      float32 `json:"duration"`
+	TickInterval  float32 `json:"tick_interval"`
+	DamagePerTick int32   `json:"damage_per_tick"`
+	Slow          float32 `json:"slow"`
+	Stacking      string  `json:"stacking"`
+	MaxStacks     int32   `json:"max_stacks"`
+	Immunity      float32 `json:"immunity"`
+}
+
+func (e *StatusEffectJSON) ToProto() (*pb.StatusEffectDefinition, error) {
+	effectType, found := pb.StatusEffectType_value[e.Type]
+	if !found {
+		return nil, fmt.Errorf("unknown status effect type %q", e.Type)
+	}
+	stacking := int32(pb.StatusEffectStacking_REFRESH)
+	if e.Stacking != "" {
+		if stacking, found = pb.StatusEffectStacking_value[e.Stacking]; !found {
+			return nil, fmt.Errorf("unknown status effect stacking %q", e.Stacking)
+		}
+	}
+	if e.Duration <= 0 {
+		return nil, fmt.Errorf("status effect %v should have positive duration", e.Type)
+	}
+	return &pb.StatusEffectDefinition{
+		Type:          pb.StatusEffectType(effectType),
+		Duration:      e.Duration,
+		TickInterval:  e.TickInterval,
+		DamagePerTick: e.DamagePerTick,
+		Slow:          e.Slow,
+		Stacking:      pb.StatusEffectStacking(stacking),
+		MaxStacks:     e.MaxStacks,
+		Immunity:      e.Immunity,
+	}, nil
+}
+
+// statusEffect is effect active on player, its damage is dealt every interval ticks
+type statusEffect struct {
+	definition    *pb.StatusEffectDefinition
+	sourceId      int32
+	ticksLeft     int
+	intervalTicks int
+	nextTickIn    int
+	stacks        int32
+}
+
+func (p *SyncPlayer) stunned() bool {
+	for _, effect := range p.statusEffects {
+		if effect.definition.Type == pb.StatusEffectType_STUN {
+			return true
+		}
+	}
+	return false
+}
+
+// speedMultiplier returns part of max speed left to player by slowing and stunning effects
+func (p *SyncPlayer) speedMultiplier() float32 {
+	multiplier := float32(1)
+	for _, effect := range p.statusEffects {
+		switch effect.definition.Type {
+		case pb.StatusEffectType_STUN:
+			return 0
+		case pb.StatusEffectType_SLOW:
+			multiplier *= 1 - effect.definition.Slow*float32(effect.stacks)
+		}
+	}
+	if multiplier < 0 {
+		return 0
+	}
+	return multiplier
+}
+
+// applyOnHitEffects puts on-hit effects of the weapon on player unless player is immune to them
+func (g *GameSession) applyOnHitEffects(player *SyncPlayer, sourceId int32, definitions []*pb.StatusEffectDefinition) {
+	if player.PlayerInfo.Hp <= 0 {
+		return
+	}
+	for _, definition := range definitions {
+		g.applyStatusEffect(player, sourceId, definition)
+	}
+	updateStatusEffectsInfo(player, g.cfg.TicksPerSecond)
+}
+
+// applyStatusEffect refreshes, stacks or ignores effect of the same type already active on player
+// depending on stacking rule of the new effect, stacks are not limited if max stacks is not set
+func (g *GameSession) applyStatusEffect(player *SyncPlayer, sourceId int32, definition *pb.StatusEffectDefinition) {
+	if player.effectImmunity[definition.Type] > 0 {
+		return
+	}
+	durationTicks := int(definition.Duration * float32(g.cfg.TicksPerSecond))
+	if durationTicks <= 0 {
+		return
+	}
+	for _, effect := range player.statusEffects {
+		if effect.definition.Type != definition.Type {
+			continue
+		}
+		switch definition.Stacking {
+		case pb.StatusEffectStacking_IGNORE:
+			return
+		case pb.StatusEffectStacking_STACK:
+			if definition.MaxStacks <= 0 || effect.stacks < definition.MaxStacks {
+				effect.stacks++
+			}
+		}
+		effect.definition = definition
+		effect.sourceId = sourceId
+		effect.ticksLeft = durationTicks
+		return
+	}
+
+	intervalTicks := int(math.Round(float64(definition.TickInterval * float32(g.cfg.TicksPerSecond))))
+	if intervalTicks < 1 {
+		intervalTicks = 1
+	}
+	player.statusEffects = append(player.statusEffects, &statusEffect{
+		definition:    definition,
+		sourceId:      sourceId,
+		ticksLeft:     durationTicks,
+		intervalTicks: intervalTicks,
+		nextTickIn:    intervalTicks,
+		stacks:        1,
+	})
+	if definition.Type == pb.StatusEffectType_STUN {
+		if player.PlayerInfo.ChannelingItem != nil {
+			interruptChannel(player)
+		}
+		player.PlayerInfo.Blocking = false
+		player.windUpTicksLeft = 0
+	}
+}
+
+// progressStatusEffects deals periodic damage of effects on behalf of players who applied them,
+// expired effects give immunity to effects of their type
+func (g *GameSession) progressStatusEffects() {
+	for _, player := range g.GameState.Players {
+		for effectType, ticksLeft := range player.effectImmunity {
+			if ticksLeft <= 1 {
+				delete(player.effectImmunity, effectType)
+			} else {
+				player.effectImmunity[effectType] = ticksLeft - 1
+			}
+		}
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 || len(player.statusEffects) == 0 {
+			continue
+		}
+		active := player.statusEffects[:0]
+		for _, effect := range player.statusEffects {
+			effect.nextTickIn--
+			if effect.nextTickIn <= 0 {
+				effect.nextTickIn = effect.intervalTicks
+				g.dealEffectDamage(player, effect)
+			}
+			effect.ticksLeft--
+			if effect.ticksLeft > 0 {
+				active = append(active, effect)
+				continue
+			}
+			if immunityTicks := int(effect.definition.Immunity * float32(g.cfg.TicksPerSecond)); immunityTicks > 0 {
+				if player.effectImmunity == nil {
+					player.effectImmunity = make(map[pb.StatusEffectType]int)
+				}
+				player.effectImmunity[effect.definition.Type] = immunityTicks
+			}
+		}
+		player.statusEffects = active
+		updateStatusEffectsInfo(player, g.cfg.TicksPerSecond)
+	}
+}
+
+func (g *GameSession) dealEffectDamage(player *SyncPlayer, effect *statusEffect) {
+	damage := effect.definition.DamagePerTick * effect.stacks
+	if damage <= 0 || player.PlayerInfo.Hp <= 0 || player.protectionTicks > 0 {
+		return
+	}
+	if effect.sourceId != NoKiller && effect.sourceId != player.PlayerInfo.PlayerId {
+		g.GameState.Players[int(effect.sourceId)].PlayerInfo.Stats.Damage += damage
+	}
+	if g.damagePlayer(player, damage) {
+		g.deadPlayers <- DeathInfo{PlayerId: player.PlayerInfo.PlayerId, AttackerId: effect.sourceId}
+	}
+}
+
+func clearStatusEffects(player *SyncPlayer) {
+	player.statusEffects = nil
+	player.effectImmunity = nil
+	player.PlayerInfo.StatusEffects = nil
+}
+
+func updateStatusEffectsInfo(player *SyncPlayer, ticksPerSecond int) {
+	if len(player.statusEffects) == 0 {
+		player.PlayerInfo.StatusEffects = nil
+		return
+	}
+	effects := make([]*pb.StatusEffect, 0, len(player.statusEffects))
+	for _, effect := range player.statusEffects {
+		effects = append(effects, &pb.StatusEffect{
+			Type:     effect.definition.Type,
+			TimeLeft: float32(effect.ticksLeft) / float32(ticksPerSecond),
+			Stacks:   effect.stacks,
+			SourceId: effect.sourceId,
+		})
+	}
+	player.PlayerInfo.StatusEffects = effects
+}
@@ -0,0 +1,115 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestOnHitBleed(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy := gs.GameState.Players[1]
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 47}
+	player.PlayerInfo.Equipment.Weapon = player.PlayerInfo.Equipment.Weapon.Deepcopy()
+	player.PlayerInfo.Equipment.Weapon.GetWeaponChars().OnHitEffects = []*pb.StatusEffectDefinition{{
+		Type:          pb.StatusEffectType_BLEED,
+		Duration:      2,
+		TickInterval:  1,
+		DamagePerTick: 3,
+		Stacking:      pb.StatusEffectStacking_STACK,
+		MaxStacks:     2,
+		Immunity:      1,
+	}}
+	// hit returns player #1 to the range of attack pushed out by knockback
+	hit := func() {
+		enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 47}
+		enemy.PlayerInfo.Velocity = nil
+		gs.DoSessionTick()
+		gs.processAttackAction(&pb.AttackAction{}, 0)
+	}
+
+	for i := 0; i < 3; i++ {
+		hit()
+	}
+	if enemy.PlayerInfo.Hp != 70 {
+		t.Fatalf("expected 3 hits on player #1, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	effects := enemy.PlayerInfo.StatusEffects
+	if len(effects) != 1 || effects[0].Type != pb.StatusEffectType_BLEED || effects[0].Stacks != 2 || effects[0].SourceId != 0 {
+		t.Fatalf("expected bleed with 2 stacks on player #1, got: %v", effects)
+	}
+
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.Hp != 64 {
+		t.Fatalf("expected bleed to deal damage of every stack, hp: %v", enemy.PlayerInfo.Hp)
+	}
+	if player.PlayerInfo.Stats.Damage != 36 {
+		t.Fatalf("expected bleed damage to be credited to player #0, damage: %v", player.PlayerInfo.Stats.Damage)
+	}
+	if gs.PrevGameStates[gs.cfg.GameStatesSaved-1].Players[1].StatusEffects[0].TimeLeft != 1 {
+		t.Fatalf("expected active effects in game state, got: %v", gs.PrevGameStates[gs.cfg.GameStatesSaved-1].Players[1].StatusEffects)
+	}
+
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.Hp != 58 || len(enemy.PlayerInfo.StatusEffects) != 0 {
+		t.Fatalf("expected bleed to expire after the last tick, hp: %v, effects: %v", enemy.PlayerInfo.Hp, enemy.PlayerInfo.StatusEffects)
+	}
+
+	hit()
+	if enemy.PlayerInfo.Hp != 48 || len(enemy.PlayerInfo.StatusEffects) != 0 {
+		t.Fatalf("expected player #1 to be immune to bleed, hp: %v, effects: %v", enemy.PlayerInfo.Hp, enemy.PlayerInfo.StatusEffects)
+	}
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	hit()
+	if len(enemy.PlayerInfo.StatusEffects) != 1 {
+		t.Fatal("expected immunity of player #1 to expire")
+	}
+}
+
+func TestStunAndSlow(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.PlayerMaxSpeed = 30
+	player := gs.GameState.Players[0]
+
+	gs.applyOnHitEffects(player, 1, []*pb.StatusEffectDefinition{
+		{Type: pb.StatusEffectType_SLOW, Duration: 1, Slow: 0.5},
+		{Type: pb.StatusEffectType_STUN, Duration: 0.5, Stacking: pb.StatusEffectStacking_IGNORE},
+	})
+	if speed := gs.maxPlayerSpeed(player, false); speed != 0 {
+		t.Fatalf("expected stunned player not to move, max speed: %v", speed)
+	}
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Block{Block: &pb.BlockAction{Active: true}}}, 0)
+	if player.PlayerInfo.Blocking {
+		t.Fatal("stunned player should not be able to act")
+	}
+
+	gs.applyOnHitEffects(player, 1, []*pb.StatusEffectDefinition{{Type: pb.StatusEffectType_STUN, Duration: 2, Stacking: pb.StatusEffectStacking_IGNORE}})
+	for i := 0; i < 15; i++ {
+		gs.DoSessionTick()
+	}
+	if player.stunned() {
+		t.Fatal("expected reapplied stun to be ignored")
+	}
+	if speed := gs.maxPlayerSpeed(player, false); speed != 15 {
+		t.Fatalf("expected slowed player to move at half speed, max speed: %v", speed)
+	}
+	for i := 0; i < 15; i++ {
+		gs.DoSessionTick()
+	}
+	if speed := gs.maxPlayerSpeed(player, false); speed != 30 || len(player.PlayerInfo.StatusEffects) != 0 {
+		t.Fatalf("expected effects to expire, max speed: %v, effects: %v", speed, player.PlayerInfo.StatusEffects)
+	}
+}
@@ -0,0 +1,195 @@
+package gamesession
+
+import (
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// Placement returns position of player's team, falls back to personal position in solo games
+func (p *SyncPlayer) Placement() int {
+	if p.TeamPosition != 0 {
+		return p.TeamPosition
+	}
+	return p.Position
+}
+
+func teamCount(playerCount, teamSize int) int {
+	if teamSize <= 1 {
+		return playerCount
+	}
+	return (playerCount + teamSize - 1) / teamSize
+}
+
+func teamId(playerId, teamSize int) int32 {
+	if teamSize <= 1 {
+		return int32(playerId)
+	}
+	return int32(playerId / teamSize)
+}
+
+func (g *GameSession) areTeammates(playerId1, playerId2 int32) bool {
+	return g.GameState.Players[int(playerId1)].PlayerInfo.TeamId == g.GameState.Players[int(playerId2)].PlayerInfo.TeamId
+}
+
+func (g *GameSession) knockdownEnabled() bool {
+	return g.cfg.TeamSize > 1 && g.cfg.KnockdownHp > 0
+}
+
+func (g *GameSession) hasStandingTeammate(player *SyncPlayer) bool {
+	for _, teammate := range g.GameState.Players {
+		if teammate == player || teammate.Position != 0 || teammate.PlayerInfo.KnockedDown {
+			continue
+		}
+		if teammate.PlayerInfo.TeamId == player.PlayerInfo.TeamId {
+			return true
+		}
+	}
+	return false
+}
+
+// processDeath knocks player down while there are teammates able to revive, kills otherwise
+func (g *GameSession) processDeath(death DeathInfo) {
+	player := g.GameState.Players[int(death.PlayerId)]
+	if player.Position != 0 {
+		return
+	}
+	if !player.PlayerInfo.KnockedDown && g.knockdownEnabled() && g.hasStandingTeammate(player) {
+		g.knockDown(player, death.AttackerId)
+		return
+	}
+	killerId := death.AttackerId
+	// knocked down player finished by safe zone or hazard is credited to the one who knocked the player down
+	if killerId < 0 && player.PlayerInfo.KnockedDown {
+		killerId = player.knockedBy
+	}
+	g.killPlayer(player, killerId)
+}
+
+func (g *GameSession) knockDown(player *SyncPlayer, attackerId int32) {
+	if player.PlayerInfo.ChannelingItem != nil {
+		interruptChannel(player)
+	}
+	player.PlayerInfo.KnockedDown = true
+	player.PlayerInfo.Hp = g.cfg.KnockdownHp
+	player.PlayerInfo.Blocking = false
+	player.PlayerInfo.Velocity = nil
+	player.windUpTicksLeft = 0
+	player.intentMovement = false
+	player.bleedDamage = 0
+	player.knockedBy = attackerId
+	clearStatusEffects(player)
+}
+
+func (g *GameSession) killPlayer(player *SyncPlayer, killerId int32) {
+	g.notifyKill(KillInfo{
+		Actor:    g.creditKill(killerId),
+		Receiver: player.PlayerInfo.Nickname,
+	})
+	player.PlayerInfo.KnockedDown = false
+	player.PlayerInfo.ReviveTimeLeft = 0
+	player.reviveTicksLeft = 0
+	clearStatusEffects(player)
+	player.Position = g.GameState.PlayersLeft
+	g.GameState.PlayersLeft -= 1
+}
+
+func (g *GameSession) processReviveAction(reviveAction *pb.ReviveAction, playerId int32) {
+	if reviveAction.PlayerId == playerId || reviveAction.PlayerId < 0 || int(reviveAction.PlayerId) >= len(g.GameState.Players) {
+		return
+	}
+	prevPlayers := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack].Players
+	reviver := prevPlayers[int(playerId)]
+	target := prevPlayers[int(reviveAction.PlayerId)]
+	if reviver.TeamId != target.TeamId || !target.KnockedDown {
+		return
+	}
+	if CalculateDistance(reviver.Position.X, reviver.Position.Y, target.Position.X, target.Position.Y) > g.cfg.ReviveRange {
+		return
+	}
+	player := g.GameState.Players[int(reviveAction.PlayerId)]
+	if !player.PlayerInfo.KnockedDown || player.reviveTicksLeft > 0 {
+		return
+	}
+	player.reviverId = playerId
+	player.reviveTicksLeft = int(g.cfg.ReviveDuration * float32(g.cfg.TicksPerSecond))
+	player.PlayerInfo.ReviveTimeLeft = g.cfg.ReviveDuration
+	if player.reviveTicksLeft <= 0 {
+		g.revivePlayer(player)
+	}
+}
+
+func (g *GameSession) revivePlayer(player *SyncPlayer) {
+	player.PlayerInfo.KnockedDown = false
+	player.PlayerInfo.ReviveTimeLeft = 0
+	player.reviveTicksLeft = 0
+	player.PlayerInfo.Hp = g.cfg.ReviveHp
+	if player.PlayerInfo.Hp <= 0 {
+		player.PlayerInfo.Hp = 1
+	}
+}
+
+// progressKnockdown advances revive of knocked down player or bleeds them out while nobody helps
+func (g *GameSession) progressKnockdown(player *SyncPlayer) {
+	if player.reviveTicksLeft > 0 {
+		reviver := g.GameState.Players[int(player.reviverId)]
+		distance := CalculateDistance(reviver.PlayerInfo.Position.X, reviver.PlayerInfo.Position.Y,
+			player.PlayerInfo.Position.X, player.PlayerInfo.Position.Y)
+		if reviver.Position == 0 && !reviver.PlayerInfo.KnockedDown && distance <= g.cfg.ReviveRange {
+			player.reviveTicksLeft--
+			player.PlayerInfo.ReviveTimeLeft = float32(player.reviveTicksLeft) / float32(g.cfg.TicksPerSecond)
+			if player.reviveTicksLeft == 0 {
+				g.revivePlayer(player)
+			}
+			return
+		}
+		player.reviveTicksLeft = 0
+		player.PlayerInfo.ReviveTimeLeft = 0
+	}
+
+	player.bleedDamage += g.cfg.KnockdownBleed / float32(g.cfg.TicksPerSecond)
+	damageDealt := int32(player.bleedDamage)
+	if damageDealt == 0 {
+		return
+	}
+	player.bleedDamage -= float32(damageDealt)
+	if g.damagePlayer(player, damageDealt) {
+		g.killPlayer(player, player.knockedBy)
+	}
+}
+
+// updateTeams finishes knocked down players of teams without standing members and assigns team positions,
+// returns false when there is less than two teams left
+func (g *GameSession) updateTeams() bool {
+	teams := teamCount(len(g.GameState.Players), g.cfg.TeamSize)
+	standing := make([]bool, teams)
+	for _, player := range g.GameState.Players {
+		if player.Position != 0 {
+			continue
+		}
+		if !player.PlayerInfo.KnockedDown {
+			standing[player.PlayerInfo.TeamId] = true
+		}
+	}
+	for _, player := range g.GameState.Players {
+		if player.Position == 0 && !standing[player.PlayerInfo.TeamId] {
+			g.killPlayer(player, player.knockedBy)
+		}
+	}
+
+	for team := 0; team < teams; team++ {
+		if standing[team] {
+			continue
+		}
+		eliminated := false
+		for _, player := range g.GameState.Players {
+			if int(player.PlayerInfo.TeamId) == team && player.TeamPosition == 0 {
+				player.TeamPosition = g.GameState.TeamsLeft
+				eliminated = true
+			}
+		}
+		if eliminated {
+			g.GameState.TeamsLeft -= 1
+		}
+	}
+
+	return g.GameState.TeamsLeft > 1
+}
@@ -0,0 +1,107 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestTeams(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	gs.cfg.TeamSize = 2
+	gs.cfg.KnockdownHp = 30
+	gs.cfg.KnockdownBleed = 30
+	gs.cfg.ReviveDuration = 1
+	gs.cfg.ReviveRange = 15
+	gs.cfg.ReviveHp = 25
+	for _, player := range gs.GameState.Players {
+		player.PlayerInfo.TeamId = teamId(int(player.PlayerInfo.PlayerId), gs.cfg.TeamSize)
+	}
+	gs.GameState.TeamsLeft = 2
+	player := gs.GameState.Players[0]
+	teammate := gs.GameState.Players[1]
+	enemy := gs.GameState.Players[2]
+	enemyTeammate := gs.GameState.Players[3]
+
+	teammate.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if teammate.PlayerInfo.Hp != 100 {
+		t.Fatalf("friendly fire should be disabled, hp: %v", teammate.PlayerInfo.Hp)
+	}
+
+	teammate.PlayerInfo.Position = &pb.Vector{X: 20, Y: 20}
+	enemy.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	enemy.PlayerInfo.Hp = 5
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	gs.DoSessionTick()
+	if !enemy.PlayerInfo.KnockedDown || enemy.Position != 0 || enemy.PlayerInfo.Hp != 29 {
+		t.Fatalf("expected player #2 to be knocked down, hp: %v, position: %v", enemy.PlayerInfo.Hp, enemy.Position)
+	}
+	if len(gs.KillNotifications) != 0 {
+		t.Fatal("knockdown should not be reported as kill")
+	}
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Attack{Attack: &pb.AttackAction{}}}, 2)
+	if player.PlayerInfo.Hp != 100 {
+		t.Fatalf("knocked down player should not be able to attack, hp: %v", player.PlayerInfo.Hp)
+	}
+
+	enemyTeammate.PlayerInfo.Position = &pb.Vector{X: 62, Y: 50}
+	gs.DoSessionTick()
+	gs.ProcessAction(&pb.Action{Action: &pb.Action_Revive{Revive: &pb.ReviveAction{PlayerId: 2}}}, 3)
+	if enemy.PlayerInfo.ReviveTimeLeft != 1 {
+		t.Fatalf("expected revive of player #2 to start, revive time: %.3f", enemy.PlayerInfo.ReviveTimeLeft)
+	}
+	for i := 0; i < 30; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.PlayerInfo.KnockedDown || enemy.PlayerInfo.Hp != 25 {
+		t.Fatalf("expected player #2 to be revived, hp: %v", enemy.PlayerInfo.Hp)
+	}
+
+	enemy.PlayerInfo.Hp = 5
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	for i := 0; i < 32; i++ {
+		gs.DoSessionTick()
+	}
+	if enemy.Position != 4 || player.PlayerInfo.Stats.Kills != 1 {
+		t.Fatalf("expected player #2 to bleed out, position: %v, kills: %v", enemy.Position, player.PlayerInfo.Stats.Kills)
+	}
+	checkIfKillNotificationIsPresent(t, gs, 0)
+	if gs.GameState.TeamsLeft != 2 {
+		t.Fatalf("team with standing player should stay in game, teams left: %v", gs.GameState.TeamsLeft)
+	}
+
+	enemyTeammate.PlayerInfo.Position = &pb.Vector{X: 50, Y: 50}
+	enemyTeammate.PlayerInfo.Hp = 5
+	enemyTeammate.PlayerInfo.Equipment.Armor = nil
+	gs.DoSessionTick()
+	gs.processAttackAction(&pb.AttackAction{}, 0)
+	if !gs.DoSessionTick() {
+		t.Fatal("expected game to end when single team is left")
+	}
+	if enemyTeammate.Position != 3 || enemyTeammate.PlayerInfo.KnockedDown {
+		t.Fatalf("last standing player of the team should die without knockdown, position: %v", enemyTeammate.Position)
+	}
+	if enemy.Placement() != 2 || enemyTeammate.Placement() != 2 || player.Placement() != 1 || teammate.Placement() != 1 {
+		t.Fatalf("unexpected team placements: %v, %v, %v, %v", player.Placement(), teammate.Placement(), enemy.Placement(), enemyTeammate.Placement())
+	}
+}
+
+func TestUndrainedKillNotifications(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	for i := 0; i < cap(gs.KillNotifications); i++ {
+		gs.KillNotifications <- KillInfo{}
+	}
+	gs.killPlayer(gs.GameState.Players[2], 0)
+	if gs.GameState.Players[0].PlayerInfo.Stats.Kills != 1 {
+		t.Fatalf("kill should be counted when kill feed is full, kills: %v", gs.GameState.Players[0].PlayerInfo.Stats.Kills)
+	}
+}
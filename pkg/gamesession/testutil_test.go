@@ -0,0 +1,227 @@
+package gamesession
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// testGameSessionConfig returns config of sessions made for tests, features with zero values stay off
+func testGameSessionConfig(playerCount int) *GameSessionConfig {
+	return &GameSessionConfig{
+		GameStatesSaved:     10,
+		GameStatesShiftBack: 1,
+		TicksPerSecond:      30,
+		PlayerCount:         playerCount,
+		PlayerPickUpRange:   10,
+		PlayerDropRange:     12,
+		PlayerRadius:        5,
+		DefaultWeapon: &pb.EquipmentItem{
+			Type:   pb.EquipmentItemType_WEAPON,
+			Rarity: pb.EquipmentItemRarity_DEFAULT,
+			Characteristics: &pb.EquipmentItem_WeaponChars{
+				WeaponChars: &pb.WeaponCharacteristics{
+					AttackPower:    10,
+					KnockbackPower: 2,
+					Range:          7,
+					AttackCone:     0.79,
+				},
+			},
+		},
+		ConsumableSlots:      2,
+		PlayerMaxStamina:     100,
+		StaminaRegen:         10,
+		AttackStaminaCost:    10,
+		BlockStaminaCost:     15,
+		BlockCone:            0.79,
+		BlockDamageReduction: 0.75,
+		ParryWindow:          0.2,
+		StaggerDuration:      1,
+		LootSeed:             1,
+	}
+}
+
+func MakeTestGameSession() (*GameSession, error) {
+	mapData, err := ioutil.ReadFile("../../test/testmap.json")
+	if err != nil {
+		return nil, fmt.Errorf("Error reading test map: %v", err)
+	}
+	gameSession, err := NewGameSessionFromMap(testGameSessionConfig(4), mapData)
+	if err != nil {
+		return nil, err
+	}
+	mapDesc := &gameSession.MapDesc
+	defaultWeapon := gameSession.cfg.DefaultWeapon
+	items := make([]*SyncItem, 0, 8)
+	helmet := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:            pb.EquipmentItemType_HELMET,
+				Rarity:          pb.EquipmentItemRarity_UNCOMMON,
+				Characteristics: &pb.EquipmentItem_HpBuff{HpBuff: 20},
+				ItemId:          0,
+			},
+			Position: &pb.Vector{X: mapDesc.LootSpawns[0], Y: mapDesc.LootSpawns[1]},
+		},
+	}
+	armor := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:            pb.EquipmentItemType_ARMOR,
+				Rarity:          pb.EquipmentItemRarity_RARE,
+				Characteristics: &pb.EquipmentItem_DamageReduction{DamageReduction: 20},
+				ItemId:          1,
+			},
+			Position: &pb.Vector{X: mapDesc.LootSpawns[2], Y: mapDesc.LootSpawns[3]},
+		},
+	}
+	helmetEnemy := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:            pb.EquipmentItemType_HELMET,
+				Rarity:          pb.EquipmentItemRarity_RARE,
+				Characteristics: &pb.EquipmentItem_HpBuff{HpBuff: 30},
+				ItemId:          2,
+			},
+			Position: &pb.Vector{X: -100, Y: -100},
+		},
+		pickedUp: true,
+	}
+	armorEnemy := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:            pb.EquipmentItemType_ARMOR,
+				Rarity:          pb.EquipmentItemRarity_UNCOMMON,
+				Characteristics: &pb.EquipmentItem_DamageReduction{DamageReduction: 15},
+				ItemId:          3,
+			},
+			Position: &pb.Vector{X: -100, Y: -100},
+		},
+		pickedUp: true,
+	}
+	weapon := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:   pb.EquipmentItemType_WEAPON,
+				Rarity: pb.EquipmentItemRarity_COMMON,
+				Characteristics: &pb.EquipmentItem_WeaponChars{WeaponChars: &pb.WeaponCharacteristics{
+					AttackPower:    20,
+					KnockbackPower: 3,
+					Range:          15,
+					AttackCone:     0.79,
+				}},
+				ItemId: 4,
+			},
+			Position: &pb.Vector{X: mapDesc.LootSpawns[4], Y: mapDesc.LootSpawns[5]},
+		},
+	}
+	helmet2 := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:            pb.EquipmentItemType_HELMET,
+				Rarity:          pb.EquipmentItemRarity_RARE,
+				Characteristics: &pb.EquipmentItem_HpBuff{HpBuff: 30},
+				ItemId:          5,
+			},
+			Position: &pb.Vector{X: mapDesc.LootSpawns[6], Y: mapDesc.LootSpawns[7]},
+		},
+	}
+	potion := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:   pb.EquipmentItemType_CONSUMABLE,
+				Rarity: pb.EquipmentItemRarity_UNCOMMON,
+				Characteristics: &pb.EquipmentItem_ConsumableChars{ConsumableChars: &pb.ConsumableCharacteristics{
+					Type:        pb.ConsumableType_POTION,
+					Heal:        40,
+					ChannelTime: 1,
+				}},
+				ItemId: 6,
+			},
+			Position: &pb.Vector{X: 45, Y: 40},
+		},
+	}
+	bandage := &SyncItem{
+		ItemInfo: &pb.DroppedEquipmentItem{
+			Item: &pb.EquipmentItem{
+				Type:   pb.EquipmentItemType_CONSUMABLE,
+				Rarity: pb.EquipmentItemRarity_COMMON,
+				Characteristics: &pb.EquipmentItem_ConsumableChars{ConsumableChars: &pb.ConsumableCharacteristics{
+					Type:        pb.ConsumableType_BANDAGE,
+					Heal:        15,
+					ChannelTime: 3,
+				}},
+				ItemId: 7,
+			},
+			Position: &pb.Vector{X: 55, Y: 40},
+		},
+	}
+	items = append(items, helmet, armor, helmetEnemy, armorEnemy, weapon, helmet2, potion, bandage)
+	players := make([]*SyncPlayer, 0, 4)
+	player := &SyncPlayer{
+		PlayerInfo: &pb.Player{
+			Nickname:  "player",
+			Hp:        100,
+			UserId:    "some-id",
+			Position:  &pb.Vector{X: 50, Y: 40},
+			Angle:     math.Pi / 2,
+			PlayerId:  0,
+			Equipment: &pb.PlayerEquipment{Weapon: defaultWeapon.Deepcopy()},
+			Stats:     &pb.PlayerStats{},
+			Stamina:   100,
+		},
+		Position: 0,
+	}
+	enemy1 := &SyncPlayer{
+		PlayerInfo: &pb.Player{
+			Nickname:  "enemy1",
+			Hp:        100,
+			UserId:    "some-id-1",
+			Position:  &pb.Vector{X: 80, Y: 20},
+			Angle:     math.Pi / 2,
+			PlayerId:  1,
+			TeamId:    1,
+			Equipment: &pb.PlayerEquipment{Weapon: defaultWeapon.Deepcopy()},
+			Stats:     &pb.PlayerStats{},
+			Stamina:   100,
+		},
+		Position: 0,
+	}
+	enemy2 := &SyncPlayer{
+		PlayerInfo: &pb.Player{
+			Nickname:  "enemy2",
+			Hp:        80,
+			UserId:    "some-id-2",
+			Position:  &pb.Vector{X: 70, Y: 80},
+			Angle:     0,
+			PlayerId:  2,
+			TeamId:    2,
+			Equipment: &pb.PlayerEquipment{Weapon: defaultWeapon.Deepcopy(), Helmet: helmetEnemy.ItemInfo.Item},
+			Stats:     &pb.PlayerStats{},
+			Stamina:   100,
+		},
+		Position: 0,
+	}
+	enemy3 := &SyncPlayer{
+		PlayerInfo: &pb.Player{
+			Nickname:  "enemy3",
+			Hp:        70,
+			UserId:    "some-id-3",
+			Position:  &pb.Vector{X: 80, Y: 80},
+			Angle:     math.Pi * 3 / 2,
+			PlayerId:  3,
+			TeamId:    3,
+			Equipment: &pb.PlayerEquipment{Weapon: defaultWeapon.Deepcopy(), Armor: armorEnemy.ItemInfo.Item},
+			Stats:     &pb.PlayerStats{},
+			Stamina:   100,
+		},
+		Position: 0,
+	}
+	players = append(players, player, enemy1, enemy2, enemy3)
+	gameSession.GameState.Players = players
+	gameSession.GameState.Items = items
+	gameSession.InitPrevGameStates()
+	return gameSession, nil
+}
@@ -1,9 +1,6 @@
 package gamesession
 
 import (
-	"sort"
-
-	"github.com/Tarliton/collision2d"
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
 )
 
@@ -11,78 +8,87 @@ func (g *GameSession) DoSessionTick() bool {
 	g.Lock()
 	defer g.Unlock()
 
-	sortedPlayers := make([]SortedPlayer, 0, g.cfg.PlayerCount)
 	players := make([]*pb.Player, 0, g.cfg.PlayerCount)
 	items := make([]*pb.DroppedEquipmentItem, 0, g.cfg.PlayerCount)
-	playersAlive := 0
+	attackers := make([]int32, 0)
+	g.applyQueuedActions()
+	g.currentTick++
+
+	if g.GameState.SafeZone != nil {
+		g.applySafeZone()
+	}
+	g.applyHazards()
+	g.progressStatusEffects()
 
 	moreMessages := true
 	for moreMessages {
 		select {
-		case deadPlayer := <-g.deadPlayers:
-			g.GameState.Players[deadPlayer].Position = g.GameState.PlayersLeft
-			g.GameState.PlayersLeft -= 1
+		case death := <-g.deadPlayers:
+			g.mode.PlayerDied(g, death)
 		default:
 			moreMessages = false
 		}
 
 	}
+	if !g.mode.Tick(g) {
+		g.mode.FinishMatch(g)
+		return true
+	}
+	g.updateMapEntities()
 
 	for _, player := range g.GameState.Players {
-		if player.Position != 0 {
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 {
 			continue
 		}
-		playersAlive++
-		minGotYou := player.PlayerInfo.Position.X - g.cfg.PlayerRadius
-		maxGotYou := player.PlayerInfo.Position.X + g.cfg.PlayerRadius
-		intervals := make(map[int]bool)
-
-		for _, sEntity := range g.sortedEntities {
-			if sEntity.value > maxGotYou {
-				break
-			}
-			if sEntity.value < minGotYou {
-				if sEntity.start {
-					intervals[sEntity.entityId] = true
-				} else {
-					intervals[sEntity.entityId] = false
-				}
-				continue
-			}
-			intervals[sEntity.entityId] = true
+		g.integrateMovement(player)
+		g.progressChannel(player)
+		g.progressStamina(player)
+		if g.progressWindUp(player) {
+			attackers = append(attackers, player.PlayerInfo.PlayerId)
 		}
 
-		playerBody := collision2d.NewCircle(collision2d.NewVector(float64(player.PlayerInfo.Position.X), float64(player.PlayerInfo.Position.Y)),
-			float64(g.cfg.PlayerRadius))
-
-		for entityId, in := range intervals {
-			if !in {
-				continue
-			}
-			_, info := collision2d.TestPolygonCircle(g.unmovableEntities[entityId], playerBody)
-			if info.Overlap < 0 {
-				continue
-			}
+		if g.collidesWithEntities(player.PlayerInfo.Position.X, player.PlayerInfo.Position.Y) {
 			player.PlayerInfo.Position.X = g.PrevGameStates[g.cfg.GameStatesSaved-1].Players[int(player.PlayerInfo.PlayerId)].Position.X
 			player.PlayerInfo.Position.Y = g.PrevGameStates[g.cfg.GameStatesSaved-1].Players[int(player.PlayerInfo.PlayerId)].Position.Y
-			break
+			if player.PlayerInfo.Velocity != nil {
+				player.PlayerInfo.Velocity.X = 0
+				player.PlayerInfo.Velocity.Y = 0
+			}
 		}
-		sortedPlayers = append(sortedPlayers, SortedPlayer{playerId: player.PlayerInfo.PlayerId, value: minGotYou, start: true},
-			SortedPlayer{playerId: player.PlayerInfo.PlayerId, value: maxGotYou, start: false})
-		players = append(players, player.PlayerInfo.Deepcopy())
 	}
 
-	if playersAlive < 2 {
-		return true
+	if g.cfg.PlayerCollision {
+		g.separatePlayers(indexPlayers(g.GameState.Players, g.cfg.PlayerRadius))
 	}
 
-	sort.SliceStable(sortedPlayers, func(i, j int) bool { return sortedPlayers[i].value < sortedPlayers[j].value })
-
 	for _, item := range g.GameState.Items {
 		items = append(items, item.ItemInfo.Deepcopy())
 	}
 
-	newPrevGameState := PrevGameState{SortedPlayers: sortedPlayers, Players: players, Items: items, PlayersLeft: g.GameState.PlayersLeft}
+	for _, attacker := range attackers {
+		g.resolveAttack(attacker)
+	}
+	g.moveProjectiles(indexPlayers(g.GameState.Players, g.cfg.PlayerRadius))
+	projectiles := make([]*pb.Projectile, 0, len(g.GameState.Projectiles))
+	for _, projectile := range g.GameState.Projectiles {
+		projectiles = append(projectiles, projectile.ProjectileInfo.Deepcopy())
+	}
+	for _, player := range g.GameState.Players {
+		players = append(players, player.PlayerInfo.Deepcopy())
+	}
+	mapEntities := make([]*pb.MapEntity, 0, len(g.GameState.MapEntities))
+	for _, entity := range g.GameState.MapEntities {
+		if entity.isDynamic() {
+			mapEntities = append(mapEntities, entity.EntityInfo.Deepcopy())
+		}
+	}
+
+	newPrevGameState := PrevGameState{Tick: g.currentTick, PlayerGrid: indexPlayers(g.GameState.Players, g.cfg.PlayerRadius),
+		ItemGrid: indexItems(items, g.cfg.PlayerRadius), ProjectileGrid: indexProjectiles(projectiles, g.cfg.PlayerRadius), Players: players, Items: items, Projectiles: projectiles, MapEntities: mapEntities, PlayersLeft: g.GameState.PlayersLeft,
+		TeamsLeft: g.GameState.TeamsLeft, TimeLeft: g.GameState.TimeLeft}
+	if g.GameState.SafeZone != nil {
+		newPrevGameState.SafeZone = g.GameState.SafeZone.ToProto()
+	}
 	g.PrevGameStates = g.PrevGameStates[1:]
 	g.PrevGameStates = append(g.PrevGameStates, newPrevGameState)
 	return false
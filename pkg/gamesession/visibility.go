@@ -0,0 +1,87 @@
+package gamesession
+
+import (
+	"github.com/Tarliton/collision2d"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+type ConcealmentJSON struct {
+	Name     string    `json:"name,omitempty"`
+	Vertexes []float64 `json:"vertexes"`
+}
+
+// NewConcealments creates areas like bushes, players inside them are seen only by players in the same area
+func NewConcealments(concealmentDescs []ConcealmentJSON) []collision2d.Polygon {
+	concealments := make([]collision2d.Polygon, 0, len(concealmentDescs))
+	for _, desc := range concealmentDescs {
+		concealments = append(concealments, collision2d.NewPolygon(collision2d.NewVector(0, 0), collision2d.NewVector(0, 0), 0, desc.Vertexes))
+	}
+	return concealments
+}
+
+// concealmentAt returns index of concealment area containing position or -1
+func (g *GameSession) concealmentAt(position *pb.Vector) int {
+	point := collision2d.NewVector(float64(position.X), float64(position.Y))
+	for i, concealment := range g.concealments {
+		if collision2d.PointInPolygon(point, concealment) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (g *GameSession) inView(viewer *pb.Player, position *pb.Vector, radius float32) bool {
+	if CalculateDistance(viewer.Position.X, viewer.Position.Y, position.X, position.Y) > g.cfg.ViewRadius+radius {
+		return false
+	}
+	return !g.cfg.ViewOcclusion || g.hasLineOfSight(viewer.Position.X, viewer.Position.Y, position.X, position.Y)
+}
+
+// VisibleStateProto returns game state sent to client of player without entities out of player's view,
+// teammates are always visible, should be called under session lock
+func (g *GameSession) VisibleStateProto(playerId int32) *pb.GameState {
+	state := g.StateProto()
+	if g.cfg.ViewRadius <= 0 {
+		return state
+	}
+	viewer := state.Players[playerId]
+	viewerConcealment := g.concealmentAt(viewer.Position)
+
+	players := make([]*pb.Player, 0, len(state.Players))
+	for _, player := range state.Players {
+		if player.PlayerId != playerId && !g.areTeammates(playerId, player.PlayerId) {
+			if !g.inView(viewer, player.Position, g.cfg.PlayerRadius) {
+				continue
+			}
+			if concealment := g.concealmentAt(player.Position); concealment >= 0 && concealment != viewerConcealment {
+				continue
+			}
+		}
+		players = append(players, player)
+	}
+	state.Players = players
+
+	snapshot := g.PrevGameStates[g.cfg.GameStatesSaved-g.cfg.GameStatesShiftBack]
+	items := make([]*pb.DroppedEquipmentItem, 0, len(state.DroppedItems))
+	for _, itemId := range snapshot.ItemGrid.QueryRadius(viewer.Position.X, viewer.Position.Y, g.cfg.ViewRadius) {
+		if item := state.DroppedItems[itemId]; g.inView(viewer, item.Position, 0) {
+			items = append(items, item)
+		}
+	}
+	state.DroppedItems = items
+
+	// own projectiles are kept wherever they are, grid candidates are sorted so they are merged in state order
+	candidates := snapshot.ProjectileGrid.QueryRadius(viewer.Position.X, viewer.Position.Y, g.cfg.ViewRadius)
+	projectiles := make([]*pb.Projectile, 0, len(state.Projectiles))
+	for i, projectile := range state.Projectiles {
+		candidate := len(candidates) > 0 && candidates[0] == i
+		if candidate {
+			candidates = candidates[1:]
+		}
+		if projectile.OwnerId == playerId || candidate && g.inView(viewer, projectile.Position, 0) {
+			projectiles = append(projectiles, projectile)
+		}
+	}
+	state.Projectiles = projectiles
+	return state
+}
@@ -0,0 +1,63 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func visiblePlayerIds(state *pb.GameState) []int32 {
+	ids := make([]int32, 0, len(state.Players))
+	for _, player := range state.Players {
+		ids = append(ids, player.PlayerId)
+	}
+	return ids
+}
+
+func checkVisiblePlayers(t *testing.T, gs *GameSession, playerId int32, expected ...int32) {
+	t.Helper()
+	gs.DoSessionTick()
+	ids := visiblePlayerIds(gs.VisibleStateProto(playerId))
+	if len(ids) != len(expected) {
+		t.Fatalf("expected visible players %v, got %v", expected, ids)
+	}
+	for i := range ids {
+		if ids[i] != expected[i] {
+			t.Fatalf("expected visible players %v, got %v", expected, ids)
+		}
+	}
+}
+
+func TestVisibleStateProto(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[0]
+	enemy1 := gs.GameState.Players[1]
+	enemy2 := gs.GameState.Players[2]
+	enemy3 := gs.GameState.Players[3]
+
+	checkVisiblePlayers(t, gs, 0, 0, 1, 2, 3)
+
+	gs.cfg.ViewRadius = 35
+	checkVisiblePlayers(t, gs, 0, 0, 1)
+	full := gs.StateProto()
+	visible := gs.VisibleStateProto(0)
+	if len(visible.DroppedItems) == 0 || len(visible.DroppedItems) >= len(full.DroppedItems) {
+		t.Fatalf("expected only near items to be visible, got %v of %v", len(visible.DroppedItems), len(full.DroppedItems))
+	}
+
+	gs.cfg.ViewRadius = 60
+	gs.cfg.ViewOcclusion = true
+	player.PlayerInfo.Position = &pb.Vector{X: 50, Y: 55}
+	enemy1.PlayerInfo.Position = &pb.Vector{X: 95, Y: 55}
+	enemy2.PlayerInfo.Position = &pb.Vector{X: 62, Y: 90}
+	enemy3.PlayerInfo.Position = &pb.Vector{X: 50, Y: 5}
+	checkVisiblePlayers(t, gs, 0, 0, 3)
+
+	enemy1.PlayerInfo.TeamId = player.PlayerInfo.TeamId
+	player.PlayerInfo.Position = &pb.Vector{X: 58, Y: 88}
+	checkVisiblePlayers(t, gs, 0, 0, 1, 2)
+	checkVisiblePlayers(t, gs, 2, 0, 1, 2)
+}
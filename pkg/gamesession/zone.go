@@ -0,0 +1,149 @@
+package gamesession
+
+import (
+	"fmt"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const SafeZoneKillActor = "#safe_zone"
+
+type SafeZonePhaseJSON struct {
+	Delay           float32   `json:"delay"`
+	ShrinkDuration  float32   `json:"shrink_duration"`
+	TargetRadius    float32   `json:"target_radius"`
+	TargetCenter    []float32 `json:"target_center"`
+	DamagePerSecond float32   `json:"damage_per_second"`
+}
+
+type SafeZoneJSON struct {
+	Center []float32           `json:"center"`
+	Radius float32             `json:"radius"`
+	Phases []SafeZonePhaseJSON `json:"phases"`
+}
+
+type SafeZone struct {
+	phases         []SafeZonePhaseJSON
+	phase          int
+	phaseTicks     int
+	ticksPerSecond int
+	startX         float32
+	startY         float32
+	startRadius    float32
+	CenterX        float32
+	CenterY        float32
+	Radius         float32
+	TargetX        float32
+	TargetY        float32
+	TargetRadius   float32
+}
+
+func NewSafeZone(desc *SafeZoneJSON, ticksPerSecond int) (*SafeZone, error) {
+	if len(desc.Center) != 2 {
+		return nil, fmt.Errorf("safe zone center should have 2 coordinates")
+	}
+	for i, phase := range desc.Phases {
+		if len(phase.TargetCenter) != 0 && len(phase.TargetCenter) != 2 {
+			return nil, fmt.Errorf("safe zone phase #%d target center should have 2 coordinates", i)
+		}
+	}
+	zone := &SafeZone{
+		phases:         desc.Phases,
+		ticksPerSecond: ticksPerSecond,
+		startX:         desc.Center[0],
+		startY:         desc.Center[1],
+		startRadius:    desc.Radius,
+		CenterX:        desc.Center[0],
+		CenterY:        desc.Center[1],
+		Radius:         desc.Radius,
+	}
+	zone.setTarget()
+	return zone, nil
+}
+
+func (z *SafeZone) setTarget() {
+	z.TargetX = z.startX
+	z.TargetY = z.startY
+	z.TargetRadius = z.startRadius
+	if z.phase >= len(z.phases) {
+		return
+	}
+	phase := z.phases[z.phase]
+	if len(phase.TargetCenter) == 2 {
+		z.TargetX = phase.TargetCenter[0]
+		z.TargetY = phase.TargetCenter[1]
+	}
+	z.TargetRadius = phase.TargetRadius
+}
+
+func (z *SafeZone) Advance() {
+	if z.phase >= len(z.phases) {
+		return
+	}
+	phase := z.phases[z.phase]
+	z.phaseTicks++
+	delayTicks := int(phase.Delay * float32(z.ticksPerSecond))
+	shrinkTicks := int(phase.ShrinkDuration * float32(z.ticksPerSecond))
+	if z.phaseTicks < delayTicks {
+		return
+	}
+	if z.phaseTicks >= delayTicks+shrinkTicks {
+		z.CenterX, z.CenterY, z.Radius = z.TargetX, z.TargetY, z.TargetRadius
+		z.startX, z.startY, z.startRadius = z.TargetX, z.TargetY, z.TargetRadius
+		z.phase++
+		z.phaseTicks = 0
+		z.setTarget()
+		return
+	}
+	progress := float32(z.phaseTicks-delayTicks) / float32(shrinkTicks)
+	z.CenterX = z.startX + (z.TargetX-z.startX)*progress
+	z.CenterY = z.startY + (z.TargetY-z.startY)*progress
+	z.Radius = z.startRadius + (z.TargetRadius-z.startRadius)*progress
+}
+
+func (z *SafeZone) DamagePerTick() float32 {
+	if len(z.phases) == 0 {
+		return 0
+	}
+	phase := z.phase
+	if phase >= len(z.phases) {
+		phase = len(z.phases) - 1
+	}
+	return z.phases[phase].DamagePerSecond / float32(z.ticksPerSecond)
+}
+
+func (z *SafeZone) Contains(position *pb.Vector) bool {
+	return CalculateDistance(z.CenterX, z.CenterY, position.X, position.Y) <= z.Radius
+}
+
+func (z *SafeZone) ToProto() *pb.SafeZone {
+	return &pb.SafeZone{
+		Center:       &pb.Vector{X: z.CenterX, Y: z.CenterY},
+		Radius:       z.Radius,
+		TargetCenter: &pb.Vector{X: z.TargetX, Y: z.TargetY},
+		TargetRadius: z.TargetRadius,
+	}
+}
+
+func (g *GameSession) applySafeZone() {
+	zone := g.GameState.SafeZone
+	zone.Advance()
+	damage := zone.DamagePerTick()
+	if damage <= 0 {
+		return
+	}
+	for _, player := range g.GameState.Players {
+		if player.Position != 0 || player.PlayerInfo.Hp <= 0 || zone.Contains(player.PlayerInfo.Position) {
+			continue
+		}
+		player.zoneDamage += damage
+		damageDealt := int32(player.zoneDamage)
+		if damageDealt == 0 {
+			continue
+		}
+		player.zoneDamage -= float32(damageDealt)
+		if g.damagePlayer(player, damageDealt) {
+			g.deadPlayers <- DeathInfo{PlayerId: player.PlayerInfo.PlayerId, AttackerId: NoKiller}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package gamesession
+
+import (
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+func TestSafeZone(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	zone := gs.GameState.SafeZone
+	if zone == nil {
+		t.Fatal("expected safe zone to be loaded from test map")
+	}
+
+	for i := 0; i < 60; i++ {
+		gs.DoSessionTick()
+	}
+	if zone.Radius != 75 {
+		t.Fatalf("expected safe zone not to shrink during delay, radius: %.3f", zone.Radius)
+	}
+
+	for i := 0; i < 45; i++ {
+		gs.DoSessionTick()
+	}
+	if zone.Radius >= 75 || zone.Radius <= 20 {
+		t.Fatalf("expected safe zone to be shrinking, radius: %.3f", zone.Radius)
+	}
+	if prevZone := gs.PrevGameStates[len(gs.PrevGameStates)-1].SafeZone; prevZone == nil || prevZone.Radius != zone.Radius {
+		t.Fatal("expected safe zone to be saved in previous game state")
+	}
+
+	for i := 0; i < 45; i++ {
+		gs.DoSessionTick()
+	}
+	if zone.Radius != 20 || zone.CenterX != 50 || zone.CenterY != 50 {
+		t.Fatalf("expected first phase to finish at (50, 50) radius 20, got (%.3f, %.3f) radius %.3f", zone.CenterX, zone.CenterY, zone.Radius)
+	}
+	if zone.TargetRadius != 0 || zone.TargetX != 40 || zone.TargetY != 60 {
+		t.Fatalf("expected next target at (40, 60) radius 0, got (%.3f, %.3f) radius %.3f", zone.TargetX, zone.TargetY, zone.TargetRadius)
+	}
+	if gs.GameState.Players[0].PlayerInfo.Hp != 100 {
+		t.Fatalf("player inside safe zone should not be damaged, hp: %v", gs.GameState.Players[0].PlayerInfo.Hp)
+	}
+	if gs.GameState.Players[1].PlayerInfo.Hp >= 100 {
+		t.Fatal("player outside safe zone should be damaged")
+	}
+
+	ticks := 0
+	for !gs.DoSessionTick() {
+		ticks++
+		if ticks > 300 {
+			t.Fatal("expected safe zone to finish the game")
+		}
+	}
+
+	select {
+	case killInfo := <-gs.KillNotifications:
+		if killInfo.Actor != SafeZoneKillActor {
+			t.Fatalf("expected safe zone to be the actor of kill notification, got: %v", killInfo.Actor)
+		}
+	default:
+		t.Fatal("expected kill notification to be created")
+	}
+}
+
+func TestSafeZoneInterruptsChannel(t *testing.T) {
+	gs, err := MakeTestGameSession()
+	if err != nil {
+		t.Fatalf("unable to create test game session: %v", err)
+	}
+	player := gs.GameState.Players[1]
+	bandage := gs.GameState.Items[7].ItemInfo.Item
+	player.PlayerInfo.Equipment.Consumables = append(player.PlayerInfo.Equipment.Consumables, bandage)
+	gs.processUseItemAction(&pb.UseItemAction{ItemId: bandage.ItemId}, 1)
+	if player.PlayerInfo.ChannelingItem == nil {
+		t.Fatal("expected player #1 to channel bandage")
+	}
+
+	gs.GameState.SafeZone.Radius = 10
+	for i := 0; i < 3; i++ {
+		gs.applySafeZone()
+	}
+	if player.PlayerInfo.Hp != 99 || player.PlayerInfo.ChannelingItem != nil {
+		t.Fatalf("expected zone damage to interrupt channeling, hp: %v, channeling: %v", player.PlayerInfo.Hp, player.PlayerInfo.ChannelingItem)
+	}
+}
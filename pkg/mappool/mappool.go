@@ -0,0 +1,159 @@
+package mappool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// PoolFile is the name of pool description in map pool directory
+const PoolFile = "pool.json"
+
+const (
+	// MapKey is label or annotation of allocated game server with id of map for the match
+	MapKey = "medieval-game-server/map"
+	// RotationKey is label or annotation of allocated game server with number of the match in map rotation
+	RotationKey = "medieval-game-server/rotation"
+)
+
+type MapEntry struct {
+	Id         string `json:"id"`
+	File       string `json:"file"`
+	Weight     int    `json:"weight"`
+	MinPlayers int    `json:"min_players"`
+	MaxPlayers int    `json:"max_players"`
+}
+
+// UnmarshalJSON keeps weight 1 for entries without weight, so only explicit 0 disables the map
+func (e *MapEntry) UnmarshalJSON(data []byte) error {
+	type mapEntry MapEntry
+	entry := mapEntry{Weight: 1}
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return err
+	}
+	*e = MapEntry(entry)
+	return nil
+}
+
+// Pool is set of maps matches are played on, files of maps are relative to pool directory
+type Pool struct {
+	Maps []MapEntry `json:"maps"`
+	dir  string
+}
+
+// LoadPool reads pool description from map pool directory, weight of map is 1 if not set and 0 takes map
+// out of rotation and random picks, player count is not limited from above if max players is not set
+func LoadPool(dir string) (*Pool, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, PoolFile))
+	if err != nil {
+		return nil, fmt.Errorf("Error opening map pool: %v", err)
+	}
+	pool := &Pool{dir: dir}
+	if err = json.Unmarshal(data, pool); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling map pool: %v", err)
+	}
+	if len(pool.Maps) == 0 {
+		return nil, fmt.Errorf("map pool is empty")
+	}
+	ids := make(map[string]bool, len(pool.Maps))
+	totalWeight := 0
+	for i := range pool.Maps {
+		entry := &pool.Maps[i]
+		switch {
+		case entry.Id == "":
+			return nil, fmt.Errorf("map %v has no id", i)
+		case ids[entry.Id]:
+			return nil, fmt.Errorf("map %v is listed twice", entry.Id)
+		case entry.Weight < 0:
+			return nil, fmt.Errorf("map %v has negative weight", entry.Id)
+		case entry.MaxPlayers != 0 && entry.MaxPlayers < entry.MinPlayers:
+			return nil, fmt.Errorf("map %v has max players less than min players", entry.Id)
+		}
+		ids[entry.Id] = true
+		totalWeight += entry.Weight
+		if _, err = os.Stat(pool.Path(entry)); err != nil {
+			return nil, fmt.Errorf("map %v: %v", entry.Id, err)
+		}
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("all maps of the pool are disabled by zero weight")
+	}
+	return pool, nil
+}
+
+// Path returns path to map file of the entry
+func (p *Pool) Path(entry *MapEntry) string {
+	return filepath.Join(p.dir, entry.File)
+}
+
+// Fits returns true if map can be played by given amount of players
+func (e *MapEntry) Fits(playerCount int) bool {
+	return playerCount >= e.MinPlayers && (e.MaxPlayers == 0 || playerCount <= e.MaxPlayers)
+}
+
+// Select picks map for the match: map requested by allocation metadata, map of the rotation
+// if metadata has number of the match or weighted random map otherwise, disabled maps are picked only on request
+func (p *Pool) Select(metadata map[string]string, playerCount int, random *rand.Rand) (*MapEntry, error) {
+	if id, ok := metadata[MapKey]; ok {
+		for i := range p.Maps {
+			if p.Maps[i].Id != id {
+				continue
+			}
+			if !p.Maps[i].Fits(playerCount) {
+				return nil, fmt.Errorf("map %v does not fit %v players", id, playerCount)
+			}
+			return &p.Maps[i], nil
+		}
+		return nil, fmt.Errorf("map %v is not in the pool", id)
+	}
+
+	candidates := make([]*MapEntry, 0, len(p.Maps))
+	totalWeight := 0
+	for i := range p.Maps {
+		if p.Maps[i].Weight > 0 && p.Maps[i].Fits(playerCount) {
+			candidates = append(candidates, &p.Maps[i])
+			totalWeight += p.Maps[i].Weight
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no maps in the pool fit %v players", playerCount)
+	}
+
+	if match, ok := metadata[RotationKey]; ok {
+		number, err := strconv.ParseInt(match, 10, 64)
+		if err != nil || number < 0 {
+			return nil, fmt.Errorf("invalid number of match in rotation: %v", match)
+		}
+		return rotation(candidates, totalWeight, number), nil
+	}
+	pick := random.Intn(totalWeight)
+	for _, candidate := range candidates {
+		if pick < candidate.Weight {
+			return candidate, nil
+		}
+		pick -= candidate.Weight
+	}
+	return candidates[len(candidates)-1], nil
+}
+
+// rotation returns map of the match by smooth weighted round robin, so every cycle of total weight matches
+// plays each map weight times and spreads repeats of the same map apart
+func rotation(candidates []*MapEntry, totalWeight int, number int64) *MapEntry {
+	current := make([]int, len(candidates))
+	chosen := 0
+	for step := int64(0); step <= number%int64(totalWeight); step++ {
+		chosen = 0
+		for i, candidate := range candidates {
+			current[i] += candidate.Weight
+			if current[i] > current[chosen] {
+				chosen = i
+			}
+		}
+		current[chosen] -= totalWeight
+	}
+	return candidates[chosen]
+}
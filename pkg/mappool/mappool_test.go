@@ -0,0 +1,123 @@
+package mappool
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func loadTestPool(t *testing.T) *Pool {
+	absPath, _ := filepath.Abs("")
+	pool, err := LoadPool(filepath.Join(absPath[:len(absPath)-11], "/test/maps"))
+	if err != nil {
+		t.Fatalf("unable to load map pool: %v", err)
+	}
+	return pool
+}
+
+func TestSelect(t *testing.T) {
+	pool := loadTestPool(t)
+	random := rand.New(rand.NewSource(1))
+
+	testCases := []struct {
+		name        string
+		metadata    map[string]string
+		playerCount int
+		expected    string
+		err         bool
+	}{
+		{name: "requested map", metadata: map[string]string{MapKey: "testmap_v2", RotationKey: "0"}, playerCount: 2, expected: "testmap_v2"},
+		{name: "unknown map", metadata: map[string]string{MapKey: "castle"}, playerCount: 2, err: true},
+		{name: "requested map does not fit", metadata: map[string]string{MapKey: "testmap"}, playerCount: 1, err: true},
+		{name: "rotation skips maps not fitting", metadata: map[string]string{RotationKey: "0"}, playerCount: 1, expected: "testmap_v2"},
+		{name: "no maps fit", metadata: map[string]string{}, playerCount: 3, err: true},
+		{name: "invalid rotation", metadata: map[string]string{RotationKey: "first"}, playerCount: 2, err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			entry, err := pool.Select(tc.metadata, tc.playerCount, random)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected error, got map %v", entry.Id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to select map: %v", err)
+			}
+			if entry.Id != tc.expected {
+				t.Fatalf("expected map %v, got %v", tc.expected, entry.Id)
+			}
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	pool := loadTestPool(t)
+	expected := []string{"testmap", "testmap_v2", "testmap", "testmap", "testmap_v2", "testmap"}
+	for i, id := range expected {
+		entry, err := pool.Select(map[string]string{RotationKey: strconv.Itoa(i)}, 2, nil)
+		if err != nil {
+			t.Fatalf("unable to select map of match %v: %v", i, err)
+		}
+		if entry.Id != id {
+			t.Fatalf("expected map %v for match %v, got %v", id, i, entry.Id)
+		}
+	}
+
+	random := rand.New(rand.NewSource(1))
+	picks := make(map[string]int)
+	for i := 0; i < 300; i++ {
+		entry, err := pool.Select(nil, 2, random)
+		if err != nil {
+			t.Fatalf("unable to select random map: %v", err)
+		}
+		picks[entry.Id]++
+	}
+	if picks["testmap"] < 150 || picks["testmap_v2"] < 50 {
+		t.Fatalf("random maps do not follow weights: %v", picks)
+	}
+}
+
+func TestLoadPoolWeights(t *testing.T) {
+	dir := t.TempDir()
+	for _, file := range []string{"a.json", "b.json"} {
+		if err := ioutil.WriteFile(filepath.Join(dir, file), []byte("{}"), 0644); err != nil {
+			t.Fatalf("unable to write map file: %v", err)
+		}
+	}
+	writePool := func(pool string) {
+		if err := ioutil.WriteFile(filepath.Join(dir, PoolFile), []byte(pool), 0644); err != nil {
+			t.Fatalf("unable to write pool file: %v", err)
+		}
+	}
+
+	writePool(`{"maps": [{"id": "a", "file": "a.json"}, {"id": "b", "file": "b.json", "weight": 0}]}`)
+	pool, err := LoadPool(dir)
+	if err != nil {
+		t.Fatalf("unable to load map pool: %v", err)
+	}
+	if pool.Maps[0].Weight != 1 || pool.Maps[1].Weight != 0 {
+		t.Fatalf("expected default weight 1 and explicit weight 0, got %v and %v", pool.Maps[0].Weight, pool.Maps[1].Weight)
+	}
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		entry, err := pool.Select(map[string]string{RotationKey: strconv.Itoa(i)}, 2, random)
+		if err != nil || entry.Id != "a" {
+			t.Fatalf("disabled map should not be in rotation, got %v, %v", entry, err)
+		}
+		if entry, err = pool.Select(nil, 2, random); err != nil || entry.Id != "a" {
+			t.Fatalf("disabled map should not be picked randomly, got %v, %v", entry, err)
+		}
+	}
+	if entry, err := pool.Select(map[string]string{MapKey: "b"}, 2, random); err != nil || entry.Id != "b" {
+		t.Fatalf("disabled map should be playable on request, got %v, %v", entry, err)
+	}
+
+	writePool(`{"maps": [{"id": "a", "file": "a.json", "weight": 0}]}`)
+	if _, err = LoadPool(dir); err == nil {
+		t.Fatal("expected error for pool without enabled maps")
+	}
+}
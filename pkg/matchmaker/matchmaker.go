@@ -2,6 +2,7 @@ package matchmaker
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"agones.dev/agones/pkg/client/clientset/versioned"
 	"github.com/amikhailau/medieval-game-server/pkg/allocation"
 	"github.com/amikhailau/medieval-game-server/pkg/auth"
+	"github.com/amikhailau/medieval-game-server/pkg/mappool"
 	"github.com/amikhailau/medieval-game-server/pkg/mpb"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus/ctxlogrus"
@@ -46,6 +48,7 @@ type MatchmakerServer struct {
 	playersMatchmaked map[string]bool
 	matchData         *cache.Cache
 	cfg               *MatchmakerServerConfig
+	matchesAllocated  int64
 }
 
 var _ mpb.MatchmakerServer = &MatchmakerServer{}
@@ -193,7 +196,9 @@ func (s *MatchmakerServer) checkIfLobbyFits(logger *logrus.Logger) {
 		if s.cfg.AgonesClient != nil {
 			retryAllocationTries := 1
 			for retryAllocationTries >= 0 {
-				alloc, err = allocation.AllocateGameServer(s.cfg.AgonesClient)
+				alloc, err = allocation.AllocateGameServer(s.cfg.AgonesClient, map[string]string{
+					mappool.RotationKey: strconv.FormatInt(s.matchesAllocated, 10),
+				})
 				if err != nil {
 					logger.Errorf("Allocation of game server failed: %v", err)
 					retryAllocationTries -= 1
@@ -206,6 +211,7 @@ func (s *MatchmakerServer) checkIfLobbyFits(logger *logrus.Logger) {
 					}
 					return
 				} else {
+					s.matchesAllocated++
 					break
 				}
 			}
@@ -1,15 +1,59 @@
 package pb
 
+func (x *StatusEffectDefinition) Deepcopy() *StatusEffectDefinition {
+	statusEffectDefinition := StatusEffectDefinition{
+		Type:          x.Type,
+		Duration:      x.Duration,
+		TickInterval:  x.TickInterval,
+		DamagePerTick: x.DamagePerTick,
+		Slow:          x.Slow,
+		Stacking:      x.Stacking,
+		MaxStacks:     x.MaxStacks,
+		Immunity:      x.Immunity,
+	}
+	return &statusEffectDefinition
+}
+
+func (x *StatusEffect) Deepcopy() *StatusEffect {
+	statusEffect := StatusEffect{
+		Type:     x.Type,
+		TimeLeft: x.TimeLeft,
+		Stacks:   x.Stacks,
+		SourceId: x.SourceId,
+	}
+	return &statusEffect
+}
+
 func (x *WeaponCharacteristics) Deepcopy() *WeaponCharacteristics {
+	var newOnHitEffects []*StatusEffectDefinition
+	for _, effect := range x.OnHitEffects {
+		newOnHitEffects = append(newOnHitEffects, effect.Deepcopy())
+	}
 	weaponCharacteristics := WeaponCharacteristics{
-		AttackPower:    x.AttackPower,
-		Range:          x.Range,
-		AttackCone:     x.AttackCone,
-		KnockbackPower: x.KnockbackPower,
+		AttackPower:           x.AttackPower,
+		Range:                 x.Range,
+		AttackCone:            x.AttackCone,
+		KnockbackPower:        x.KnockbackPower,
+		ProjectileSpeed:       x.ProjectileSpeed,
+		ProjectileMaxDistance: x.ProjectileMaxDistance,
+		Ammo:                  x.Ammo,
+		AttackInterval:        x.AttackInterval,
+		WindUp:                x.WindUp,
+		WallSlamDamage:        x.WallSlamDamage,
+		OnHitEffects:          newOnHitEffects,
 	}
 	return &weaponCharacteristics
 }
 
+func (x *ConsumableCharacteristics) Deepcopy() *ConsumableCharacteristics {
+	consumableCharacteristics := ConsumableCharacteristics{
+		Type:        x.Type,
+		Heal:        x.Heal,
+		ChannelTime: x.ChannelTime,
+	}
+	return &consumableCharacteristics
+}
+
 func (x *EquipmentItem) Deepcopy() *EquipmentItem {
 	equipmentItem := EquipmentItem{
 		Type:   x.Type,
@@ -26,6 +70,10 @@ func (x *EquipmentItem) Deepcopy() *EquipmentItem {
 	if damageReduction := x.GetDamageReduction(); damageReduction != 0 {
 		equipmentItem.Characteristics = &EquipmentItem_DamageReduction{DamageReduction: damageReduction}
 	}
+	if chars := x.GetConsumableChars(); chars != nil {
+		newChars := chars.Deepcopy()
+		equipmentItem.Characteristics = &EquipmentItem_ConsumableChars{ConsumableChars: newChars}
+	}
 	return &equipmentItem
 }
 
@@ -66,10 +114,15 @@ func (x *PlayerEquipment) Deepcopy() *PlayerEquipment {
 	if x.Weapon != nil {
 		newWeapon = x.Weapon.Deepcopy()
 	}
+	newConsumables := make([]*EquipmentItem, 0, len(x.Consumables))
+	for _, consumable := range x.Consumables {
+		newConsumables = append(newConsumables, consumable.Deepcopy())
+	}
 	playerEquipment := PlayerEquipment{
-		Helmet: newHelmet,
-		Armor:  newArmor,
-		Weapon: newWeapon,
+		Helmet:      newHelmet,
+		Armor:       newArmor,
+		Weapon:      newWeapon,
+		Consumables: newConsumables,
 	}
 	return &playerEquipment
 }
@@ -78,6 +131,7 @@ func (x *PlayerStats) Deepcopy() *PlayerStats {
 	newStats := &PlayerStats{
 		Kills:  x.Kills,
 		Damage: x.Damage,
+		Deaths: x.Deaths,
 	}
 	return newStats
 }
@@ -95,15 +149,83 @@ func (x *Player) Deepcopy() *Player {
 	if x.Stats != nil {
 		newStats = x.Stats.Deepcopy()
 	}
+	var newVelocity *Vector
+	if x.Velocity != nil {
+		newVelocity = x.Velocity.Deepcopy()
+	}
+	var newChannelingItem *EquipmentItem
+	if x.ChannelingItem != nil {
+		newChannelingItem = x.ChannelingItem.Deepcopy()
+	}
+	var newStatusEffects []*StatusEffect
+	for _, effect := range x.StatusEffects {
+		newStatusEffects = append(newStatusEffects, effect.Deepcopy())
+	}
 	player := Player{
-		Nickname:  x.Nickname,
-		Hp:        x.Hp,
-		Equipment: newEquipment,
-		UserId:    x.UserId,
-		Position:  newPosition,
-		Angle:     x.Angle,
-		PlayerId:  x.PlayerId,
-		Stats:     newStats,
+		Nickname:            x.Nickname,
+		Hp:                  x.Hp,
+		Equipment:           newEquipment,
+		UserId:              x.UserId,
+		Position:            newPosition,
+		Angle:               x.Angle,
+		PlayerId:            x.PlayerId,
+		Stats:               newStats,
+		ChannelingItem:      newChannelingItem,
+		ChannelTimeLeft:     x.ChannelTimeLeft,
+		Stamina:             x.Stamina,
+		Blocking:            x.Blocking,
+		StaggerTimeLeft:     x.StaggerTimeLeft,
+		Velocity:            newVelocity,
+		TeamId:              x.TeamId,
+		KnockedDown:         x.KnockedDown,
+		ReviveTimeLeft:      x.ReviveTimeLeft,
+		RespawnTimeLeft:     x.RespawnTimeLeft,
+		SpawnProtectionLeft: x.SpawnProtectionLeft,
+		LastProcessedInput:  x.LastProcessedInput,
+		StatusEffects:       newStatusEffects,
 	}
 	return &player
 }
+
+func (x *SafeZone) Deepcopy() *SafeZone {
+	var newCenter *Vector
+	if x.Center != nil {
+		newCenter = x.Center.Deepcopy()
+	}
+	var newTargetCenter *Vector
+	if x.TargetCenter != nil {
+		newTargetCenter = x.TargetCenter.Deepcopy()
+	}
+	safeZone := SafeZone{
+		Center:       newCenter,
+		Radius:       x.Radius,
+		TargetCenter: newTargetCenter,
+		TargetRadius: x.TargetRadius,
+	}
+	return &safeZone
+}
+
+func (x *Projectile) Deepcopy() *Projectile {
+	var newPosition *Vector
+	if x.Position != nil {
+		newPosition = x.Position.Deepcopy()
+	}
+	projectile := Projectile{
+		ProjectileId: x.ProjectileId,
+		OwnerId:      x.OwnerId,
+		Position:     newPosition,
+		Angle:        x.Angle,
+	}
+	return &projectile
+}
+
+func (x *MapEntity) Deepcopy() *MapEntity {
+	mapEntity := MapEntity{
+		EntityId:  x.EntityId,
+		Type:      x.Type,
+		Hp:        x.Hp,
+		Destroyed: x.Destroyed,
+		Open:      x.Open,
+	}
+	return &mapEntity
+}
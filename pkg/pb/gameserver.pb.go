@@ -24,9 +24,10 @@ const (
 type EquipmentItemType int32
 
 const (
-	EquipmentItemType_HELMET EquipmentItemType = 0
-	EquipmentItemType_ARMOR  EquipmentItemType = 1
-	EquipmentItemType_WEAPON EquipmentItemType = 2
+	EquipmentItemType_HELMET     EquipmentItemType = 0
+	EquipmentItemType_ARMOR      EquipmentItemType = 1
+	EquipmentItemType_WEAPON     EquipmentItemType = 2
+	EquipmentItemType_CONSUMABLE EquipmentItemType = 3
 )
 
 // Enum value maps for EquipmentItemType.
@@ -35,11 +36,13 @@ var (
 		0: "HELMET",
 		1: "ARMOR",
 		2: "WEAPON",
+		3: "CONSUMABLE",
 	}
 	EquipmentItemType_value = map[string]int32{
-		"HELMET": 0,
-		"ARMOR":  1,
-		"WEAPON": 2,
+		"HELMET":     0,
+		"ARMOR":      1,
+		"WEAPON":     2,
+		"CONSUMABLE": 3,
 	}
 )
 
@@ -128,11 +131,260 @@ func (EquipmentItemRarity) EnumDescriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{1}
 }
 
+type ConsumableType int32
+
+const (
+	ConsumableType_BANDAGE ConsumableType = 0
+	ConsumableType_POTION  ConsumableType = 1
+)
+
+// Enum value maps for ConsumableType.
+var (
+	ConsumableType_name = map[int32]string{
+		0: "BANDAGE",
+		1: "POTION",
+	}
+	ConsumableType_value = map[string]int32{
+		"BANDAGE": 0,
+		"POTION":  1,
+	}
+)
+
+func (x ConsumableType) Enum() *ConsumableType {
+	p := new(ConsumableType)
+	*p = x
+	return p
+}
+
+func (x ConsumableType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsumableType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gameserver_proto_enumTypes[2].Descriptor()
+}
+
+func (ConsumableType) Type() protoreflect.EnumType {
+	return &file_gameserver_proto_enumTypes[2]
+}
+
+func (x ConsumableType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsumableType.Descriptor instead.
+func (ConsumableType) EnumDescriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{2}
+}
+
+type MapEntityType int32
+
+const (
+	MapEntityType_WALL      MapEntityType = 0
+	MapEntityType_DOOR      MapEntityType = 1
+	MapEntityType_BARRICADE MapEntityType = 2
+	MapEntityType_CRATE     MapEntityType = 3
+)
+
+// Enum value maps for MapEntityType.
+var (
+	MapEntityType_name = map[int32]string{
+		0: "WALL",
+		1: "DOOR",
+		2: "BARRICADE",
+		3: "CRATE",
+	}
+	MapEntityType_value = map[string]int32{
+		"WALL":      0,
+		"DOOR":      1,
+		"BARRICADE": 2,
+		"CRATE":     3,
+	}
+)
+
+func (x MapEntityType) Enum() *MapEntityType {
+	p := new(MapEntityType)
+	*p = x
+	return p
+}
+
+func (x MapEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MapEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gameserver_proto_enumTypes[3].Descriptor()
+}
+
+func (MapEntityType) Type() protoreflect.EnumType {
+	return &file_gameserver_proto_enumTypes[3]
+}
+
+func (x MapEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MapEntityType.Descriptor instead.
+func (MapEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{3}
+}
+
+type StatusEffectType int32
+
+const (
+	StatusEffectType_BLEED StatusEffectType = 0
+	StatusEffectType_STUN  StatusEffectType = 1
+	StatusEffectType_SLOW  StatusEffectType = 2
+	StatusEffectType_BURN  StatusEffectType = 3
+)
+
+// Enum value maps for StatusEffectType.
+var (
+	StatusEffectType_name = map[int32]string{
+		0: "BLEED",
+		1: "STUN",
+		2: "SLOW",
+		3: "BURN",
+	}
+	StatusEffectType_value = map[string]int32{
+		"BLEED": 0,
+		"STUN":  1,
+		"SLOW":  2,
+		"BURN":  3,
+	}
+)
+
+func (x StatusEffectType) Enum() *StatusEffectType {
+	p := new(StatusEffectType)
+	*p = x
+	return p
+}
+
+func (x StatusEffectType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEffectType) Descriptor() protoreflect.EnumDescriptor {
+	return file_gameserver_proto_enumTypes[4].Descriptor()
+}
+
+func (StatusEffectType) Type() protoreflect.EnumType {
+	return &file_gameserver_proto_enumTypes[4]
+}
+
+func (x StatusEffectType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEffectType.Descriptor instead.
+func (StatusEffectType) EnumDescriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{4}
+}
+
+type StatusEffectStacking int32
+
+const (
+	StatusEffectStacking_REFRESH StatusEffectStacking = 0
+	StatusEffectStacking_STACK   StatusEffectStacking = 1
+	StatusEffectStacking_IGNORE  StatusEffectStacking = 2
+)
+
+// Enum value maps for StatusEffectStacking.
+var (
+	StatusEffectStacking_name = map[int32]string{
+		0: "REFRESH",
+		1: "STACK",
+		2: "IGNORE",
+	}
+	StatusEffectStacking_value = map[string]int32{
+		"REFRESH": 0,
+		"STACK":   1,
+		"IGNORE":  2,
+	}
+)
+
+func (x StatusEffectStacking) Enum() *StatusEffectStacking {
+	p := new(StatusEffectStacking)
+	*p = x
+	return p
+}
+
+func (x StatusEffectStacking) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusEffectStacking) Descriptor() protoreflect.EnumDescriptor {
+	return file_gameserver_proto_enumTypes[5].Descriptor()
+}
+
+func (StatusEffectStacking) Type() protoreflect.EnumType {
+	return &file_gameserver_proto_enumTypes[5]
+}
+
+func (x StatusEffectStacking) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusEffectStacking.Descriptor instead.
+func (StatusEffectStacking) EnumDescriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{5}
+}
+
+type HitZone int32
+
+const (
+	HitZone_BODY HitZone = 0
+	HitZone_HEAD HitZone = 1
+	HitZone_BACK HitZone = 2
+)
+
+// Enum value maps for HitZone.
+var (
+	HitZone_name = map[int32]string{
+		0: "BODY",
+		1: "HEAD",
+		2: "BACK",
+	}
+	HitZone_value = map[string]int32{
+		"BODY": 0,
+		"HEAD": 1,
+		"BACK": 2,
+	}
+)
+
+func (x HitZone) Enum() *HitZone {
+	p := new(HitZone)
+	*p = x
+	return p
+}
+
+func (x HitZone) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HitZone) Descriptor() protoreflect.EnumDescriptor {
+	return file_gameserver_proto_enumTypes[6].Descriptor()
+}
+
+func (HitZone) Type() protoreflect.EnumType {
+	return &file_gameserver_proto_enumTypes[6]
+}
+
+func (x HitZone) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HitZone.Descriptor instead.
+func (HitZone) EnumDescriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{6}
+}
+
 type NotificationType int32
 
 const (
 	NotificationType_CONNECT    NotificationType = 0
 	NotificationType_DISCONNECT NotificationType = 1
+	NotificationType_PONG       NotificationType = 2
 )
 
 // Enum value maps for NotificationType.
@@ -140,10 +392,12 @@ var (
 	NotificationType_name = map[int32]string{
 		0: "CONNECT",
 		1: "DISCONNECT",
+		2: "PONG",
 	}
 	NotificationType_value = map[string]int32{
 		"CONNECT":    0,
 		"DISCONNECT": 1,
+		"PONG":       2,
 	}
 )
 
@@ -158,11 +412,11 @@ func (x NotificationType) String() string {
 }
 
 func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_gameserver_proto_enumTypes[2].Descriptor()
+	return file_gameserver_proto_enumTypes[7].Descriptor()
 }
 
 func (NotificationType) Type() protoreflect.EnumType {
-	return &file_gameserver_proto_enumTypes[2]
+	return &file_gameserver_proto_enumTypes[7]
 }
 
 func (x NotificationType) Number() protoreflect.EnumNumber {
@@ -171,7 +425,7 @@ func (x NotificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotificationType.Descriptor instead.
 func (NotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{2}
+	return file_gameserver_proto_rawDescGZIP(), []int{7}
 }
 
 type ServerNotificationType int32
@@ -183,6 +437,9 @@ const (
 	ServerNotificationType_PLAYER_ATTACKED     ServerNotificationType = 3
 	ServerNotificationType_GAME_STARTED        ServerNotificationType = 4
 	ServerNotificationType_GAME_FINISHED       ServerNotificationType = 5
+	ServerNotificationType_ATTACK_REJECTED     ServerNotificationType = 6
+	ServerNotificationType_PING                ServerNotificationType = 7
+	ServerNotificationType_PLAYER_HIT          ServerNotificationType = 8
 )
 
 // Enum value maps for ServerNotificationType.
@@ -194,6 +451,9 @@ var (
 		3: "PLAYER_ATTACKED",
 		4: "GAME_STARTED",
 		5: "GAME_FINISHED",
+		6: "ATTACK_REJECTED",
+		7: "PING",
+		8: "PLAYER_HIT",
 	}
 	ServerNotificationType_value = map[string]int32{
 		"PLAYER_CONNECTED":    0,
@@ -202,6 +462,9 @@ var (
 		"PLAYER_ATTACKED":     3,
 		"GAME_STARTED":        4,
 		"GAME_FINISHED":       5,
+		"ATTACK_REJECTED":     6,
+		"PING":                7,
+		"PLAYER_HIT":          8,
 	}
 )
 
@@ -216,11 +479,11 @@ func (x ServerNotificationType) String() string {
 }
 
 func (ServerNotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_gameserver_proto_enumTypes[3].Descriptor()
+	return file_gameserver_proto_enumTypes[8].Descriptor()
 }
 
 func (ServerNotificationType) Type() protoreflect.EnumType {
-	return &file_gameserver_proto_enumTypes[3]
+	return &file_gameserver_proto_enumTypes[8]
 }
 
 func (x ServerNotificationType) Number() protoreflect.EnumNumber {
@@ -229,7 +492,7 @@ func (x ServerNotificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServerNotificationType.Descriptor instead.
 func (ServerNotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{3}
+	return file_gameserver_proto_rawDescGZIP(), []int{8}
 }
 
 type WeaponCharacteristics struct {
@@ -237,10 +500,17 @@ type WeaponCharacteristics struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttackPower    int32   `protobuf:"varint,1,opt,name=attack_power,json=attackPower,proto3" json:"attack_power,omitempty"`
-	Range          float32 `protobuf:"fixed32,2,opt,name=range,proto3" json:"range,omitempty"`
-	AttackCone     float32 `protobuf:"fixed32,3,opt,name=attack_cone,json=attackCone,proto3" json:"attack_cone,omitempty"`
-	KnockbackPower float32 `protobuf:"fixed32,4,opt,name=knockback_power,json=knockbackPower,proto3" json:"knockback_power,omitempty"`
+	AttackPower           int32                     `protobuf:"varint,1,opt,name=attack_power,json=attackPower,proto3" json:"attack_power,omitempty"`
+	Range                 float32                   `protobuf:"fixed32,2,opt,name=range,proto3" json:"range,omitempty"`
+	AttackCone            float32                   `protobuf:"fixed32,3,opt,name=attack_cone,json=attackCone,proto3" json:"attack_cone,omitempty"`
+	KnockbackPower        float32                   `protobuf:"fixed32,4,opt,name=knockback_power,json=knockbackPower,proto3" json:"knockback_power,omitempty"`
+	ProjectileSpeed       float32                   `protobuf:"fixed32,5,opt,name=projectile_speed,json=projectileSpeed,proto3" json:"projectile_speed,omitempty"`
+	ProjectileMaxDistance float32                   `protobuf:"fixed32,6,opt,name=projectile_max_distance,json=projectileMaxDistance,proto3" json:"projectile_max_distance,omitempty"`
+	Ammo                  int32                     `protobuf:"varint,7,opt,name=ammo,proto3" json:"ammo,omitempty"`
+	AttackInterval        float32                   `protobuf:"fixed32,8,opt,name=attack_interval,json=attackInterval,proto3" json:"attack_interval,omitempty"`
+	WindUp                float32                   `protobuf:"fixed32,9,opt,name=wind_up,json=windUp,proto3" json:"wind_up,omitempty"`
+	WallSlamDamage        int32                     `protobuf:"varint,10,opt,name=wall_slam_damage,json=wallSlamDamage,proto3" json:"wall_slam_damage,omitempty"`
+	OnHitEffects          []*StatusEffectDefinition `protobuf:"bytes,11,rep,name=on_hit_effects,json=onHitEffects,proto3" json:"on_hit_effects,omitempty"`
 }
 
 func (x *WeaponCharacteristics) Reset() {
@@ -303,23 +573,72 @@ func (x *WeaponCharacteristics) GetKnockbackPower() float32 {
 	return 0
 }
 
-type EquipmentItem struct {
+func (x *WeaponCharacteristics) GetProjectileSpeed() float32 {
+	if x != nil {
+		return x.ProjectileSpeed
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetProjectileMaxDistance() float32 {
+	if x != nil {
+		return x.ProjectileMaxDistance
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetAmmo() int32 {
+	if x != nil {
+		return x.Ammo
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetAttackInterval() float32 {
+	if x != nil {
+		return x.AttackInterval
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetWindUp() float32 {
+	if x != nil {
+		return x.WindUp
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetWallSlamDamage() int32 {
+	if x != nil {
+		return x.WallSlamDamage
+	}
+	return 0
+}
+
+func (x *WeaponCharacteristics) GetOnHitEffects() []*StatusEffectDefinition {
+	if x != nil {
+		return x.OnHitEffects
+	}
+	return nil
+}
+
+type StatusEffectDefinition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   EquipmentItemType   `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.EquipmentItemType" json:"type,omitempty"`
-	Rarity EquipmentItemRarity `protobuf:"varint,2,opt,name=rarity,proto3,enum=gameserver.EquipmentItemRarity" json:"rarity,omitempty"`
-	// Types that are assignable to Characteristics:
-	//	*EquipmentItem_WeaponChars
-	//	*EquipmentItem_HpBuff
-	//	*EquipmentItem_DamageReduction
-	Characteristics isEquipmentItem_Characteristics `protobuf_oneof:"characteristics"`
-	ItemId          int32                           `protobuf:"varint,6,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Type          StatusEffectType     `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.StatusEffectType" json:"type,omitempty"`
+	Duration      float32              `protobuf:"fixed32,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	TickInterval  float32              `protobuf:"fixed32,3,opt,name=tick_interval,json=tickInterval,proto3" json:"tick_interval,omitempty"`
+	DamagePerTick int32                `protobuf:"varint,4,opt,name=damage_per_tick,json=damagePerTick,proto3" json:"damage_per_tick,omitempty"`
+	Slow          float32              `protobuf:"fixed32,5,opt,name=slow,proto3" json:"slow,omitempty"`
+	Stacking      StatusEffectStacking `protobuf:"varint,6,opt,name=stacking,proto3,enum=gameserver.StatusEffectStacking" json:"stacking,omitempty"`
+	MaxStacks     int32                `protobuf:"varint,7,opt,name=max_stacks,json=maxStacks,proto3" json:"max_stacks,omitempty"`
+	Immunity      float32              `protobuf:"fixed32,8,opt,name=immunity,proto3" json:"immunity,omitempty"`
 }
 
-func (x *EquipmentItem) Reset() {
-	*x = EquipmentItem{}
+func (x *StatusEffectDefinition) Reset() {
+	*x = StatusEffectDefinition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -327,13 +646,13 @@ func (x *EquipmentItem) Reset() {
 	}
 }
 
-func (x *EquipmentItem) String() string {
+func (x *StatusEffectDefinition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EquipmentItem) ProtoMessage() {}
+func (*StatusEffectDefinition) ProtoMessage() {}
 
-func (x *EquipmentItem) ProtoReflect() protoreflect.Message {
+func (x *StatusEffectDefinition) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,93 +664,80 @@ func (x *EquipmentItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EquipmentItem.ProtoReflect.Descriptor instead.
-func (*EquipmentItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusEffectDefinition.ProtoReflect.Descriptor instead.
+func (*StatusEffectDefinition) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *EquipmentItem) GetType() EquipmentItemType {
+func (x *StatusEffectDefinition) GetType() StatusEffectType {
 	if x != nil {
 		return x.Type
 	}
-	return EquipmentItemType_HELMET
+	return StatusEffectType_BLEED
 }
 
-func (x *EquipmentItem) GetRarity() EquipmentItemRarity {
+func (x *StatusEffectDefinition) GetDuration() float32 {
 	if x != nil {
-		return x.Rarity
+		return x.Duration
 	}
-	return EquipmentItemRarity_DEFAULT
+	return 0
 }
 
-func (m *EquipmentItem) GetCharacteristics() isEquipmentItem_Characteristics {
-	if m != nil {
-		return m.Characteristics
+func (x *StatusEffectDefinition) GetTickInterval() float32 {
+	if x != nil {
+		return x.TickInterval
 	}
-	return nil
+	return 0
 }
 
-func (x *EquipmentItem) GetWeaponChars() *WeaponCharacteristics {
-	if x, ok := x.GetCharacteristics().(*EquipmentItem_WeaponChars); ok {
-		return x.WeaponChars
+func (x *StatusEffectDefinition) GetDamagePerTick() int32 {
+	if x != nil {
+		return x.DamagePerTick
 	}
-	return nil
+	return 0
 }
 
-func (x *EquipmentItem) GetHpBuff() int32 {
-	if x, ok := x.GetCharacteristics().(*EquipmentItem_HpBuff); ok {
-		return x.HpBuff
+func (x *StatusEffectDefinition) GetSlow() float32 {
+	if x != nil {
+		return x.Slow
 	}
 	return 0
 }
 
-func (x *EquipmentItem) GetDamageReduction() int32 {
-	if x, ok := x.GetCharacteristics().(*EquipmentItem_DamageReduction); ok {
-		return x.DamageReduction
+func (x *StatusEffectDefinition) GetStacking() StatusEffectStacking {
+	if x != nil {
+		return x.Stacking
 	}
-	return 0
+	return StatusEffectStacking_REFRESH
 }
 
-func (x *EquipmentItem) GetItemId() int32 {
+func (x *StatusEffectDefinition) GetMaxStacks() int32 {
 	if x != nil {
-		return x.ItemId
+		return x.MaxStacks
 	}
 	return 0
 }
 
-type isEquipmentItem_Characteristics interface {
-	isEquipmentItem_Characteristics()
-}
-
-type EquipmentItem_WeaponChars struct {
-	WeaponChars *WeaponCharacteristics `protobuf:"bytes,3,opt,name=weapon_chars,json=weaponChars,proto3,oneof"`
-}
-
-type EquipmentItem_HpBuff struct {
-	HpBuff int32 `protobuf:"varint,4,opt,name=hp_buff,json=hpBuff,proto3,oneof"`
-}
-
-type EquipmentItem_DamageReduction struct {
-	DamageReduction int32 `protobuf:"varint,5,opt,name=damage_reduction,json=damageReduction,proto3,oneof"`
+func (x *StatusEffectDefinition) GetImmunity() float32 {
+	if x != nil {
+		return x.Immunity
+	}
+	return 0
 }
 
-func (*EquipmentItem_WeaponChars) isEquipmentItem_Characteristics() {}
-
-func (*EquipmentItem_HpBuff) isEquipmentItem_Characteristics() {}
-
-func (*EquipmentItem_DamageReduction) isEquipmentItem_Characteristics() {}
-
-type DroppedEquipmentItem struct {
+type StatusEffect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Position *Vector        `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
-	Item     *EquipmentItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Type     StatusEffectType `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.StatusEffectType" json:"type,omitempty"`
+	TimeLeft float32          `protobuf:"fixed32,2,opt,name=time_left,json=timeLeft,proto3" json:"time_left,omitempty"`
+	Stacks   int32            `protobuf:"varint,3,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	SourceId int32            `protobuf:"varint,4,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
 }
 
-func (x *DroppedEquipmentItem) Reset() {
-	*x = DroppedEquipmentItem{}
+func (x *StatusEffect) Reset() {
+	*x = StatusEffect{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,13 +745,13 @@ func (x *DroppedEquipmentItem) Reset() {
 	}
 }
 
-func (x *DroppedEquipmentItem) String() string {
+func (x *StatusEffect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DroppedEquipmentItem) ProtoMessage() {}
+func (*StatusEffect) ProtoMessage() {}
 
-func (x *DroppedEquipmentItem) ProtoReflect() protoreflect.Message {
+func (x *StatusEffect) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -457,37 +763,51 @@ func (x *DroppedEquipmentItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DroppedEquipmentItem.ProtoReflect.Descriptor instead.
-func (*DroppedEquipmentItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatusEffect.ProtoReflect.Descriptor instead.
+func (*StatusEffect) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DroppedEquipmentItem) GetPosition() *Vector {
+func (x *StatusEffect) GetType() StatusEffectType {
 	if x != nil {
-		return x.Position
+		return x.Type
 	}
-	return nil
+	return StatusEffectType_BLEED
 }
 
-func (x *DroppedEquipmentItem) GetItem() *EquipmentItem {
+func (x *StatusEffect) GetTimeLeft() float32 {
 	if x != nil {
-		return x.Item
+		return x.TimeLeft
 	}
-	return nil
+	return 0
 }
 
-type PlayerEquipment struct {
+func (x *StatusEffect) GetStacks() int32 {
+	if x != nil {
+		return x.Stacks
+	}
+	return 0
+}
+
+func (x *StatusEffect) GetSourceId() int32 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+type ConsumableCharacteristics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Helmet *EquipmentItem `protobuf:"bytes,1,opt,name=helmet,proto3" json:"helmet,omitempty"`
-	Armor  *EquipmentItem `protobuf:"bytes,2,opt,name=armor,proto3" json:"armor,omitempty"`
-	Weapon *EquipmentItem `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Type        ConsumableType `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.ConsumableType" json:"type,omitempty"`
+	Heal        int32          `protobuf:"varint,2,opt,name=heal,proto3" json:"heal,omitempty"`
+	ChannelTime float32        `protobuf:"fixed32,3,opt,name=channel_time,json=channelTime,proto3" json:"channel_time,omitempty"`
 }
 
-func (x *PlayerEquipment) Reset() {
-	*x = PlayerEquipment{}
+func (x *ConsumableCharacteristics) Reset() {
+	*x = ConsumableCharacteristics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -495,13 +815,13 @@ func (x *PlayerEquipment) Reset() {
 	}
 }
 
-func (x *PlayerEquipment) String() string {
+func (x *ConsumableCharacteristics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerEquipment) ProtoMessage() {}
+func (*ConsumableCharacteristics) ProtoMessage() {}
 
-func (x *PlayerEquipment) ProtoReflect() protoreflect.Message {
+func (x *ConsumableCharacteristics) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,43 +833,50 @@ func (x *PlayerEquipment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerEquipment.ProtoReflect.Descriptor instead.
-func (*PlayerEquipment) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsumableCharacteristics.ProtoReflect.Descriptor instead.
+func (*ConsumableCharacteristics) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PlayerEquipment) GetHelmet() *EquipmentItem {
+func (x *ConsumableCharacteristics) GetType() ConsumableType {
 	if x != nil {
-		return x.Helmet
+		return x.Type
 	}
-	return nil
+	return ConsumableType_BANDAGE
 }
 
-func (x *PlayerEquipment) GetArmor() *EquipmentItem {
+func (x *ConsumableCharacteristics) GetHeal() int32 {
 	if x != nil {
-		return x.Armor
+		return x.Heal
 	}
-	return nil
+	return 0
 }
 
-func (x *PlayerEquipment) GetWeapon() *EquipmentItem {
+func (x *ConsumableCharacteristics) GetChannelTime() float32 {
 	if x != nil {
-		return x.Weapon
+		return x.ChannelTime
 	}
-	return nil
+	return 0
 }
 
-type Vector struct {
+type EquipmentItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
-	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
+	Type   EquipmentItemType   `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.EquipmentItemType" json:"type,omitempty"`
+	Rarity EquipmentItemRarity `protobuf:"varint,2,opt,name=rarity,proto3,enum=gameserver.EquipmentItemRarity" json:"rarity,omitempty"`
+	// Types that are assignable to Characteristics:
+	//	*EquipmentItem_WeaponChars
+	//	*EquipmentItem_HpBuff
+	//	*EquipmentItem_DamageReduction
+	//	*EquipmentItem_ConsumableChars
+	Characteristics isEquipmentItem_Characteristics `protobuf_oneof:"characteristics"`
+	ItemId          int32                           `protobuf:"varint,6,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
 }
 
-func (x *Vector) Reset() {
-	*x = Vector{}
+func (x *EquipmentItem) Reset() {
+	*x = EquipmentItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -557,13 +884,13 @@ func (x *Vector) Reset() {
 	}
 }
 
-func (x *Vector) String() string {
+func (x *EquipmentItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vector) ProtoMessage() {}
+func (*EquipmentItem) ProtoMessage() {}
 
-func (x *Vector) ProtoReflect() protoreflect.Message {
+func (x *EquipmentItem) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -575,36 +902,106 @@ func (x *Vector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vector.ProtoReflect.Descriptor instead.
-func (*Vector) Descriptor() ([]byte, []int) {
+// Deprecated: Use EquipmentItem.ProtoReflect.Descriptor instead.
+func (*EquipmentItem) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Vector) GetX() float32 {
+func (x *EquipmentItem) GetType() EquipmentItemType {
 	if x != nil {
-		return x.X
+		return x.Type
+	}
+	return EquipmentItemType_HELMET
+}
+
+func (x *EquipmentItem) GetRarity() EquipmentItemRarity {
+	if x != nil {
+		return x.Rarity
+	}
+	return EquipmentItemRarity_DEFAULT
+}
+
+func (m *EquipmentItem) GetCharacteristics() isEquipmentItem_Characteristics {
+	if m != nil {
+		return m.Characteristics
+	}
+	return nil
+}
+
+func (x *EquipmentItem) GetWeaponChars() *WeaponCharacteristics {
+	if x, ok := x.GetCharacteristics().(*EquipmentItem_WeaponChars); ok {
+		return x.WeaponChars
+	}
+	return nil
+}
+
+func (x *EquipmentItem) GetHpBuff() int32 {
+	if x, ok := x.GetCharacteristics().(*EquipmentItem_HpBuff); ok {
+		return x.HpBuff
 	}
 	return 0
 }
 
-func (x *Vector) GetY() float32 {
+func (x *EquipmentItem) GetDamageReduction() int32 {
+	if x, ok := x.GetCharacteristics().(*EquipmentItem_DamageReduction); ok {
+		return x.DamageReduction
+	}
+	return 0
+}
+
+func (x *EquipmentItem) GetConsumableChars() *ConsumableCharacteristics {
+	if x, ok := x.GetCharacteristics().(*EquipmentItem_ConsumableChars); ok {
+		return x.ConsumableChars
+	}
+	return nil
+}
+
+func (x *EquipmentItem) GetItemId() int32 {
 	if x != nil {
-		return x.Y
+		return x.ItemId
 	}
 	return 0
 }
 
-type PlayerStats struct {
+type isEquipmentItem_Characteristics interface {
+	isEquipmentItem_Characteristics()
+}
+
+type EquipmentItem_WeaponChars struct {
+	WeaponChars *WeaponCharacteristics `protobuf:"bytes,3,opt,name=weapon_chars,json=weaponChars,proto3,oneof"`
+}
+
+type EquipmentItem_HpBuff struct {
+	HpBuff int32 `protobuf:"varint,4,opt,name=hp_buff,json=hpBuff,proto3,oneof"`
+}
+
+type EquipmentItem_DamageReduction struct {
+	DamageReduction int32 `protobuf:"varint,5,opt,name=damage_reduction,json=damageReduction,proto3,oneof"`
+}
+
+type EquipmentItem_ConsumableChars struct {
+	ConsumableChars *ConsumableCharacteristics `protobuf:"bytes,7,opt,name=consumable_chars,json=consumableChars,proto3,oneof"`
+}
+
+func (*EquipmentItem_WeaponChars) isEquipmentItem_Characteristics() {}
+
+func (*EquipmentItem_HpBuff) isEquipmentItem_Characteristics() {}
+
+func (*EquipmentItem_DamageReduction) isEquipmentItem_Characteristics() {}
+
+func (*EquipmentItem_ConsumableChars) isEquipmentItem_Characteristics() {}
+
+type DroppedEquipmentItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Damage int32 `protobuf:"varint,1,opt,name=damage,proto3" json:"damage,omitempty"`
-	Kills  int32 `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	Position *Vector        `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Item     *EquipmentItem `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *PlayerStats) Reset() {
-	*x = PlayerStats{}
+func (x *DroppedEquipmentItem) Reset() {
+	*x = DroppedEquipmentItem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,13 +1009,13 @@ func (x *PlayerStats) Reset() {
 	}
 }
 
-func (x *PlayerStats) String() string {
+func (x *DroppedEquipmentItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlayerStats) ProtoMessage() {}
+func (*DroppedEquipmentItem) ProtoMessage() {}
 
-func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+func (x *DroppedEquipmentItem) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -630,42 +1027,38 @@ func (x *PlayerStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
-func (*PlayerStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use DroppedEquipmentItem.ProtoReflect.Descriptor instead.
+func (*DroppedEquipmentItem) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PlayerStats) GetDamage() int32 {
+func (x *DroppedEquipmentItem) GetPosition() *Vector {
 	if x != nil {
-		return x.Damage
+		return x.Position
 	}
-	return 0
+	return nil
 }
 
-func (x *PlayerStats) GetKills() int32 {
+func (x *DroppedEquipmentItem) GetItem() *EquipmentItem {
 	if x != nil {
-		return x.Kills
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-type Player struct {
+type PlayerEquipment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nickname  string           `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Hp        int32            `protobuf:"varint,2,opt,name=hp,proto3" json:"hp,omitempty"`
-	Equipment *PlayerEquipment `protobuf:"bytes,3,opt,name=equipment,proto3" json:"equipment,omitempty"`
-	UserId    string           `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Position  *Vector          `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
-	Angle     float32          `protobuf:"fixed32,6,opt,name=angle,proto3" json:"angle,omitempty"`
-	PlayerId  int32            `protobuf:"varint,7,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Stats     *PlayerStats     `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
+	Helmet      *EquipmentItem   `protobuf:"bytes,1,opt,name=helmet,proto3" json:"helmet,omitempty"`
+	Armor       *EquipmentItem   `protobuf:"bytes,2,opt,name=armor,proto3" json:"armor,omitempty"`
+	Weapon      *EquipmentItem   `protobuf:"bytes,3,opt,name=weapon,proto3" json:"weapon,omitempty"`
+	Consumables []*EquipmentItem `protobuf:"bytes,4,rep,name=consumables,proto3" json:"consumables,omitempty"`
 }
 
-func (x *Player) Reset() {
-	*x = Player{}
+func (x *PlayerEquipment) Reset() {
+	*x = PlayerEquipment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -673,13 +1066,13 @@ func (x *Player) Reset() {
 	}
 }
 
-func (x *Player) String() string {
+func (x *PlayerEquipment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Player) ProtoMessage() {}
+func (*PlayerEquipment) ProtoMessage() {}
 
-func (x *Player) ProtoReflect() protoreflect.Message {
+func (x *PlayerEquipment) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,79 +1084,50 @@ func (x *Player) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Player.ProtoReflect.Descriptor instead.
-func (*Player) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlayerEquipment.ProtoReflect.Descriptor instead.
+func (*PlayerEquipment) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Player) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *Player) GetHp() int32 {
-	if x != nil {
-		return x.Hp
-	}
-	return 0
-}
-
-func (x *Player) GetEquipment() *PlayerEquipment {
+func (x *PlayerEquipment) GetHelmet() *EquipmentItem {
 	if x != nil {
-		return x.Equipment
+		return x.Helmet
 	}
 	return nil
 }
 
-func (x *Player) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *Player) GetPosition() *Vector {
+func (x *PlayerEquipment) GetArmor() *EquipmentItem {
 	if x != nil {
-		return x.Position
+		return x.Armor
 	}
 	return nil
 }
 
-func (x *Player) GetAngle() float32 {
-	if x != nil {
-		return x.Angle
-	}
-	return 0
-}
-
-func (x *Player) GetPlayerId() int32 {
+func (x *PlayerEquipment) GetWeapon() *EquipmentItem {
 	if x != nil {
-		return x.PlayerId
+		return x.Weapon
 	}
-	return 0
+	return nil
 }
 
-func (x *Player) GetStats() *PlayerStats {
+func (x *PlayerEquipment) GetConsumables() []*EquipmentItem {
 	if x != nil {
-		return x.Stats
+		return x.Consumables
 	}
 	return nil
 }
 
-type GameState struct {
+type Vector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Players      []*Player               `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
-	DroppedItems []*DroppedEquipmentItem `protobuf:"bytes,2,rep,name=dropped_items,json=droppedItems,proto3" json:"dropped_items,omitempty"`
-	PlayersLeft  int32                   `protobuf:"varint,3,opt,name=players_left,json=playersLeft,proto3" json:"players_left,omitempty"`
+	X float32 `protobuf:"fixed32,1,opt,name=x,proto3" json:"x,omitempty"`
+	Y float32 `protobuf:"fixed32,2,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *GameState) Reset() {
-	*x = GameState{}
+func (x *Vector) Reset() {
+	*x = Vector{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gameserver_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -771,13 +1135,13 @@ func (x *GameState) Reset() {
 	}
 }
 
-func (x *GameState) String() string {
+func (x *Vector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameState) ProtoMessage() {}
+func (*Vector) ProtoMessage() {}
 
-func (x *GameState) ProtoReflect() protoreflect.Message {
+func (x *Vector) ProtoReflect() protoreflect.Message {
 	mi := &file_gameserver_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -789,62 +1153,1160 @@ func (x *GameState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
-func (*GameState) Descriptor() ([]byte, []int) {
+// Deprecated: Use Vector.ProtoReflect.Descriptor instead.
+func (*Vector) Descriptor() ([]byte, []int) {
 	return file_gameserver_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GameState) GetPlayers() []*Player {
+func (x *Vector) GetX() float32 {
 	if x != nil {
-		return x.Players
+		return x.X
 	}
-	return nil
+	return 0
 }
 
-func (x *GameState) GetDroppedItems() []*DroppedEquipmentItem {
+func (x *Vector) GetY() float32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+type PlayerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Damage int32 `protobuf:"varint,1,opt,name=damage,proto3" json:"damage,omitempty"`
+	Kills  int32 `protobuf:"varint,2,opt,name=kills,proto3" json:"kills,omitempty"`
+	Deaths int32 `protobuf:"varint,3,opt,name=deaths,proto3" json:"deaths,omitempty"`
+}
+
+func (x *PlayerStats) Reset() {
+	*x = PlayerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerStats) ProtoMessage() {}
+
+func (x *PlayerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerStats.ProtoReflect.Descriptor instead.
+func (*PlayerStats) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PlayerStats) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *PlayerStats) GetDeaths() int32 {
+	if x != nil {
+		return x.Deaths
+	}
+	return 0
+}
+
+type Player struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nickname            string           `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Hp                  int32            `protobuf:"varint,2,opt,name=hp,proto3" json:"hp,omitempty"`
+	Equipment           *PlayerEquipment `protobuf:"bytes,3,opt,name=equipment,proto3" json:"equipment,omitempty"`
+	UserId              string           `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Position            *Vector          `protobuf:"bytes,5,opt,name=position,proto3" json:"position,omitempty"`
+	Angle               float32          `protobuf:"fixed32,6,opt,name=angle,proto3" json:"angle,omitempty"`
+	PlayerId            int32            `protobuf:"varint,7,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Stats               *PlayerStats     `protobuf:"bytes,8,opt,name=stats,proto3" json:"stats,omitempty"`
+	ChannelingItem      *EquipmentItem   `protobuf:"bytes,9,opt,name=channeling_item,json=channelingItem,proto3" json:"channeling_item,omitempty"`
+	ChannelTimeLeft     float32          `protobuf:"fixed32,10,opt,name=channel_time_left,json=channelTimeLeft,proto3" json:"channel_time_left,omitempty"`
+	Stamina             float32          `protobuf:"fixed32,11,opt,name=stamina,proto3" json:"stamina,omitempty"`
+	Blocking            bool             `protobuf:"varint,12,opt,name=blocking,proto3" json:"blocking,omitempty"`
+	StaggerTimeLeft     float32          `protobuf:"fixed32,13,opt,name=stagger_time_left,json=staggerTimeLeft,proto3" json:"stagger_time_left,omitempty"`
+	Velocity            *Vector          `protobuf:"bytes,14,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	TeamId              int32            `protobuf:"varint,15,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	KnockedDown         bool             `protobuf:"varint,16,opt,name=knocked_down,json=knockedDown,proto3" json:"knocked_down,omitempty"`
+	ReviveTimeLeft      float32          `protobuf:"fixed32,17,opt,name=revive_time_left,json=reviveTimeLeft,proto3" json:"revive_time_left,omitempty"`
+	RespawnTimeLeft     float32          `protobuf:"fixed32,18,opt,name=respawn_time_left,json=respawnTimeLeft,proto3" json:"respawn_time_left,omitempty"`
+	SpawnProtectionLeft float32          `protobuf:"fixed32,19,opt,name=spawn_protection_left,json=spawnProtectionLeft,proto3" json:"spawn_protection_left,omitempty"`
+	LastProcessedInput  uint32           `protobuf:"varint,20,opt,name=last_processed_input,json=lastProcessedInput,proto3" json:"last_processed_input,omitempty"`
+	StatusEffects       []*StatusEffect  `protobuf:"bytes,21,rep,name=status_effects,json=statusEffects,proto3" json:"status_effects,omitempty"`
+}
+
+func (x *Player) Reset() {
+	*x = Player{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Player) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Player) ProtoMessage() {}
+
+func (x *Player) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Player.ProtoReflect.Descriptor instead.
+func (*Player) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Player) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Player) GetHp() int32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+func (x *Player) GetEquipment() *PlayerEquipment {
+	if x != nil {
+		return x.Equipment
+	}
+	return nil
+}
+
+func (x *Player) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Player) GetPosition() *Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Player) GetAngle() float32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *Player) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *Player) GetStats() *PlayerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Player) GetChannelingItem() *EquipmentItem {
+	if x != nil {
+		return x.ChannelingItem
+	}
+	return nil
+}
+
+func (x *Player) GetChannelTimeLeft() float32 {
+	if x != nil {
+		return x.ChannelTimeLeft
+	}
+	return 0
+}
+
+func (x *Player) GetStamina() float32 {
+	if x != nil {
+		return x.Stamina
+	}
+	return 0
+}
+
+func (x *Player) GetBlocking() bool {
+	if x != nil {
+		return x.Blocking
+	}
+	return false
+}
+
+func (x *Player) GetStaggerTimeLeft() float32 {
+	if x != nil {
+		return x.StaggerTimeLeft
+	}
+	return 0
+}
+
+func (x *Player) GetVelocity() *Vector {
+	if x != nil {
+		return x.Velocity
+	}
+	return nil
+}
+
+func (x *Player) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *Player) GetKnockedDown() bool {
+	if x != nil {
+		return x.KnockedDown
+	}
+	return false
+}
+
+func (x *Player) GetReviveTimeLeft() float32 {
+	if x != nil {
+		return x.ReviveTimeLeft
+	}
+	return 0
+}
+
+func (x *Player) GetRespawnTimeLeft() float32 {
+	if x != nil {
+		return x.RespawnTimeLeft
+	}
+	return 0
+}
+
+func (x *Player) GetSpawnProtectionLeft() float32 {
+	if x != nil {
+		return x.SpawnProtectionLeft
+	}
+	return 0
+}
+
+func (x *Player) GetLastProcessedInput() uint32 {
+	if x != nil {
+		return x.LastProcessedInput
+	}
+	return 0
+}
+
+func (x *Player) GetStatusEffects() []*StatusEffect {
+	if x != nil {
+		return x.StatusEffects
+	}
+	return nil
+}
+
+type SafeZone struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Center       *Vector `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	Radius       float32 `protobuf:"fixed32,2,opt,name=radius,proto3" json:"radius,omitempty"`
+	TargetCenter *Vector `protobuf:"bytes,3,opt,name=target_center,json=targetCenter,proto3" json:"target_center,omitempty"`
+	TargetRadius float32 `protobuf:"fixed32,4,opt,name=target_radius,json=targetRadius,proto3" json:"target_radius,omitempty"`
+}
+
+func (x *SafeZone) Reset() {
+	*x = SafeZone{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafeZone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeZone) ProtoMessage() {}
+
+func (x *SafeZone) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeZone.ProtoReflect.Descriptor instead.
+func (*SafeZone) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SafeZone) GetCenter() *Vector {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *SafeZone) GetRadius() float32 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *SafeZone) GetTargetCenter() *Vector {
+	if x != nil {
+		return x.TargetCenter
+	}
+	return nil
+}
+
+func (x *SafeZone) GetTargetRadius() float32 {
+	if x != nil {
+		return x.TargetRadius
+	}
+	return 0
+}
+
+type Projectile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectileId int32   `protobuf:"varint,1,opt,name=projectile_id,json=projectileId,proto3" json:"projectile_id,omitempty"`
+	OwnerId      int32   `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Position     *Vector `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Angle        float32 `protobuf:"fixed32,4,opt,name=angle,proto3" json:"angle,omitempty"`
+}
+
+func (x *Projectile) Reset() {
+	*x = Projectile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Projectile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Projectile) ProtoMessage() {}
+
+func (x *Projectile) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Projectile.ProtoReflect.Descriptor instead.
+func (*Projectile) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Projectile) GetProjectileId() int32 {
+	if x != nil {
+		return x.ProjectileId
+	}
+	return 0
+}
+
+func (x *Projectile) GetOwnerId() int32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Projectile) GetPosition() *Vector {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Projectile) GetAngle() float32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+type MapEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId  int32         `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Type      MapEntityType `protobuf:"varint,2,opt,name=type,proto3,enum=gameserver.MapEntityType" json:"type,omitempty"`
+	Hp        int32         `protobuf:"varint,3,opt,name=hp,proto3" json:"hp,omitempty"`
+	Destroyed bool          `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+	Open      bool          `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+}
+
+func (x *MapEntity) Reset() {
+	*x = MapEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEntity) ProtoMessage() {}
+
+func (x *MapEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEntity.ProtoReflect.Descriptor instead.
+func (*MapEntity) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MapEntity) GetEntityId() int32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *MapEntity) GetType() MapEntityType {
+	if x != nil {
+		return x.Type
+	}
+	return MapEntityType_WALL
+}
+
+func (x *MapEntity) GetHp() int32 {
+	if x != nil {
+		return x.Hp
+	}
+	return 0
+}
+
+func (x *MapEntity) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+func (x *MapEntity) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players      []*Player               `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	DroppedItems []*DroppedEquipmentItem `protobuf:"bytes,2,rep,name=dropped_items,json=droppedItems,proto3" json:"dropped_items,omitempty"`
+	PlayersLeft  int32                   `protobuf:"varint,3,opt,name=players_left,json=playersLeft,proto3" json:"players_left,omitempty"`
+	SafeZone     *SafeZone               `protobuf:"bytes,4,opt,name=safe_zone,json=safeZone,proto3" json:"safe_zone,omitempty"`
+	Projectiles  []*Projectile           `protobuf:"bytes,5,rep,name=projectiles,proto3" json:"projectiles,omitempty"`
+	MapEntities  []*MapEntity            `protobuf:"bytes,6,rep,name=map_entities,json=mapEntities,proto3" json:"map_entities,omitempty"`
+	TeamsLeft    int32                   `protobuf:"varint,7,opt,name=teams_left,json=teamsLeft,proto3" json:"teams_left,omitempty"`
+	TimeLeft     float32                 `protobuf:"fixed32,8,opt,name=time_left,json=timeLeft,proto3" json:"time_left,omitempty"`
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GameState) GetPlayers() []*Player {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetDroppedItems() []*DroppedEquipmentItem {
 	if x != nil {
 		return x.DroppedItems
 	}
-	return nil
+	return nil
+}
+
+func (x *GameState) GetPlayersLeft() int32 {
+	if x != nil {
+		return x.PlayersLeft
+	}
+	return 0
+}
+
+func (x *GameState) GetSafeZone() *SafeZone {
+	if x != nil {
+		return x.SafeZone
+	}
+	return nil
+}
+
+func (x *GameState) GetProjectiles() []*Projectile {
+	if x != nil {
+		return x.Projectiles
+	}
+	return nil
+}
+
+func (x *GameState) GetMapEntities() []*MapEntity {
+	if x != nil {
+		return x.MapEntities
+	}
+	return nil
+}
+
+func (x *GameState) GetTeamsLeft() int32 {
+	if x != nil {
+		return x.TeamsLeft
+	}
+	return 0
+}
+
+func (x *GameState) GetTimeLeft() float32 {
+	if x != nil {
+		return x.TimeLeft
+	}
+	return 0
+}
+
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*Action_Move
+	//	*Action_Attack
+	//	*Action_PickUp
+	//	*Action_Drop
+	//	*Action_UseItem
+	//	*Action_Block
+	//	*Action_Interact
+	//	*Action_Revive
+	Action isAction_Action `protobuf_oneof:"action"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *Action) GetAction() isAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *Action) GetMove() *MovementAction {
+	if x, ok := x.GetAction().(*Action_Move); ok {
+		return x.Move
+	}
+	return nil
+}
+
+func (x *Action) GetAttack() *AttackAction {
+	if x, ok := x.GetAction().(*Action_Attack); ok {
+		return x.Attack
+	}
+	return nil
+}
+
+func (x *Action) GetPickUp() *PickUpAction {
+	if x, ok := x.GetAction().(*Action_PickUp); ok {
+		return x.PickUp
+	}
+	return nil
+}
+
+func (x *Action) GetDrop() *DropAction {
+	if x, ok := x.GetAction().(*Action_Drop); ok {
+		return x.Drop
+	}
+	return nil
+}
+
+func (x *Action) GetUseItem() *UseItemAction {
+	if x, ok := x.GetAction().(*Action_UseItem); ok {
+		return x.UseItem
+	}
+	return nil
+}
+
+func (x *Action) GetBlock() *BlockAction {
+	if x, ok := x.GetAction().(*Action_Block); ok {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Action) GetInteract() *InteractAction {
+	if x, ok := x.GetAction().(*Action_Interact); ok {
+		return x.Interact
+	}
+	return nil
+}
+
+func (x *Action) GetRevive() *ReviveAction {
+	if x, ok := x.GetAction().(*Action_Revive); ok {
+		return x.Revive
+	}
+	return nil
+}
+
+type isAction_Action interface {
+	isAction_Action()
+}
+
+type Action_Move struct {
+	Move *MovementAction `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+}
+
+type Action_Attack struct {
+	Attack *AttackAction `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+}
+
+type Action_PickUp struct {
+	PickUp *PickUpAction `protobuf:"bytes,3,opt,name=pick_up,json=pickUp,proto3,oneof"`
+}
+
+type Action_Drop struct {
+	Drop *DropAction `protobuf:"bytes,4,opt,name=drop,proto3,oneof"`
+}
+
+type Action_UseItem struct {
+	UseItem *UseItemAction `protobuf:"bytes,5,opt,name=use_item,json=useItem,proto3,oneof"`
+}
+
+type Action_Block struct {
+	Block *BlockAction `protobuf:"bytes,6,opt,name=block,proto3,oneof"`
+}
+
+type Action_Interact struct {
+	Interact *InteractAction `protobuf:"bytes,7,opt,name=interact,proto3,oneof"`
+}
+
+type Action_Revive struct {
+	Revive *ReviveAction `protobuf:"bytes,8,opt,name=revive,proto3,oneof"`
+}
+
+func (*Action_Move) isAction_Action() {}
+
+func (*Action_Attack) isAction_Action() {}
+
+func (*Action_PickUp) isAction_Action() {}
+
+func (*Action_Drop) isAction_Action() {}
+
+func (*Action_UseItem) isAction_Action() {}
+
+func (*Action_Block) isAction_Action() {}
+
+func (*Action_Interact) isAction_Action() {}
+
+func (*Action_Revive) isAction_Action() {}
+
+type MovementAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shift     *Vector `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
+	Angle     float32 `protobuf:"fixed32,2,opt,name=angle,proto3" json:"angle,omitempty"`
+	Direction *Vector `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Sprint    bool    `protobuf:"varint,4,opt,name=sprint,proto3" json:"sprint,omitempty"`
+}
+
+func (x *MovementAction) Reset() {
+	*x = MovementAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MovementAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovementAction) ProtoMessage() {}
+
+func (x *MovementAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovementAction.ProtoReflect.Descriptor instead.
+func (*MovementAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MovementAction) GetShift() *Vector {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *MovementAction) GetAngle() float32 {
+	if x != nil {
+		return x.Angle
+	}
+	return 0
+}
+
+func (x *MovementAction) GetDirection() *Vector {
+	if x != nil {
+		return x.Direction
+	}
+	return nil
+}
+
+func (x *MovementAction) GetSprint() bool {
+	if x != nil {
+		return x.Sprint
+	}
+	return false
+}
+
+type PickUpAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId int32 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *PickUpAction) Reset() {
+	*x = PickUpAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PickUpAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickUpAction) ProtoMessage() {}
+
+func (x *PickUpAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickUpAction.ProtoReflect.Descriptor instead.
+func (*PickUpAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PickUpAction) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type DropAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot   EquipmentItemType `protobuf:"varint,1,opt,name=slot,proto3,enum=gameserver.EquipmentItemType" json:"slot,omitempty"`
+	ItemId int32             `protobuf:"varint,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *DropAction) Reset() {
+	*x = DropAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropAction) ProtoMessage() {}
+
+func (x *DropAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropAction.ProtoReflect.Descriptor instead.
+func (*DropAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DropAction) GetSlot() EquipmentItemType {
+	if x != nil {
+		return x.Slot
+	}
+	return EquipmentItemType_HELMET
+}
+
+func (x *DropAction) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type UseItemAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId int32 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+}
+
+func (x *UseItemAction) Reset() {
+	*x = UseItemAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseItemAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseItemAction) ProtoMessage() {}
+
+func (x *UseItemAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseItemAction.ProtoReflect.Descriptor instead.
+func (*UseItemAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UseItemAction) GetItemId() int32 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+type AttackAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AttackAction) Reset() {
+	*x = AttackAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttackAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackAction) ProtoMessage() {}
+
+func (x *AttackAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackAction.ProtoReflect.Descriptor instead.
+func (*AttackAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{19}
+}
+
+type BlockAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *BlockAction) Reset() {
+	*x = BlockAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockAction) ProtoMessage() {}
+
+func (x *BlockAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockAction.ProtoReflect.Descriptor instead.
+func (*BlockAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BlockAction) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type InteractAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId int32 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+}
+
+func (x *InteractAction) Reset() {
+	*x = InteractAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InteractAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractAction) ProtoMessage() {}
+
+func (x *InteractAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GameState) GetPlayersLeft() int32 {
+// Deprecated: Use InteractAction.ProtoReflect.Descriptor instead.
+func (*InteractAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InteractAction) GetEntityId() int32 {
 	if x != nil {
-		return x.PlayersLeft
+		return x.EntityId
 	}
 	return 0
 }
 
-type Action struct {
+type ReviveAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//	*Action_Move
-	//	*Action_Attack
-	//	*Action_PickUp
-	//	*Action_Drop
-	Action isAction_Action `protobuf_oneof:"action"`
+	PlayerId int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 }
 
-func (x *Action) Reset() {
-	*x = Action{}
+func (x *ReviveAction) Reset() {
+	*x = ReviveAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[8]
+		mi := &file_gameserver_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Action) String() string {
+func (x *ReviveAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Action) ProtoMessage() {}
+func (*ReviveAction) ProtoMessage() {}
 
-func (x *Action) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[8]
+func (x *ReviveAction) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,100 +2317,109 @@ func (x *Action) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Action.ProtoReflect.Descriptor instead.
-func (*Action) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReviveAction.ProtoReflect.Descriptor instead.
+func (*ReviveAction) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{22}
 }
 
-func (m *Action) GetAction() isAction_Action {
-	if m != nil {
-		return m.Action
+func (x *ReviveAction) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *Action) GetMove() *MovementAction {
-	if x, ok := x.GetAction().(*Action_Move); ok {
-		return x.Move
-	}
-	return nil
-}
+type ConnectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Action) GetAttack() *AttackAction {
-	if x, ok := x.GetAction().(*Action_Attack); ok {
-		return x.Attack
-	}
-	return nil
+	UserId    string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LocalTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`
+	Nickname  string               `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
 }
 
-func (x *Action) GetPickUp() *PickUpAction {
-	if x, ok := x.GetAction().(*Action_PickUp); ok {
-		return x.PickUp
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gameserver_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Action) GetDrop() *DropAction {
-	if x, ok := x.GetAction().(*Action_Drop); ok {
-		return x.Drop
-	}
-	return nil
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isAction_Action interface {
-	isAction_Action()
-}
+func (*ConnectRequest) ProtoMessage() {}
 
-type Action_Move struct {
-	Move *MovementAction `protobuf:"bytes,1,opt,name=move,proto3,oneof"`
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Action_Attack struct {
-	Attack *AttackAction `protobuf:"bytes,2,opt,name=attack,proto3,oneof"`
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{23}
 }
 
-type Action_PickUp struct {
-	PickUp *PickUpAction `protobuf:"bytes,3,opt,name=pick_up,json=pickUp,proto3,oneof"`
+func (x *ConnectRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-type Action_Drop struct {
-	Drop *DropAction `protobuf:"bytes,4,opt,name=drop,proto3,oneof"`
+func (x *ConnectRequest) GetLocalTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.LocalTime
+	}
+	return nil
 }
 
-func (*Action_Move) isAction_Action() {}
-
-func (*Action_Attack) isAction_Action() {}
-
-func (*Action_PickUp) isAction_Action() {}
-
-func (*Action_Drop) isAction_Action() {}
+func (x *ConnectRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
 
-type MovementAction struct {
+type ConnectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shift *Vector `protobuf:"bytes,1,opt,name=shift,proto3" json:"shift,omitempty"`
-	Angle float32 `protobuf:"fixed32,2,opt,name=angle,proto3" json:"angle,omitempty"`
+	Ping       int32                `protobuf:"varint,1,opt,name=ping,proto3" json:"ping,omitempty"`
+	Token      string               `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ServerTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	MapId      string               `protobuf:"bytes,4,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
 }
 
-func (x *MovementAction) Reset() {
-	*x = MovementAction{}
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[9]
+		mi := &file_gameserver_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MovementAction) String() string {
+func (x *ConnectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MovementAction) ProtoMessage() {}
+func (*ConnectResponse) ProtoMessage() {}
 
-func (x *MovementAction) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[9]
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,50 +2430,65 @@ func (x *MovementAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MovementAction.ProtoReflect.Descriptor instead.
-func (*MovementAction) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MovementAction) GetShift() *Vector {
+func (x *ConnectResponse) GetPing() int32 {
 	if x != nil {
-		return x.Shift
+		return x.Ping
+	}
+	return 0
+}
+
+func (x *ConnectResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConnectResponse) GetServerTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ServerTime
 	}
 	return nil
 }
 
-func (x *MovementAction) GetAngle() float32 {
+func (x *ConnectResponse) GetMapId() string {
 	if x != nil {
-		return x.Angle
+		return x.MapId
 	}
-	return 0
+	return ""
 }
 
-type PickUpAction struct {
+type Notification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ItemId int32 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Type       NotificationType     `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.NotificationType" json:"type,omitempty"`
+	ServerTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
 }
 
-func (x *PickUpAction) Reset() {
-	*x = PickUpAction{}
+func (x *Notification) Reset() {
+	*x = Notification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[10]
+		mi := &file_gameserver_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PickUpAction) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PickUpAction) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *PickUpAction) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[10]
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,43 +2499,54 @@ func (x *PickUpAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PickUpAction.ProtoReflect.Descriptor instead.
-func (*PickUpAction) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PickUpAction) GetItemId() int32 {
+func (x *Notification) GetType() NotificationType {
 	if x != nil {
-		return x.ItemId
+		return x.Type
 	}
-	return 0
+	return NotificationType_CONNECT
 }
 
-type DropAction struct {
+func (x *Notification) GetServerTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ServerTime
+	}
+	return nil
+}
+
+type ClientMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slot EquipmentItemType `protobuf:"varint,1,opt,name=slot,proto3,enum=gameserver.EquipmentItemType" json:"slot,omitempty"`
+	// Types that are assignable to Message:
+	//	*ClientMessage_Action
+	//	*ClientMessage_Notification
+	Message  isClientMessage_Message `protobuf_oneof:"message"`
+	Sequence uint32                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *DropAction) Reset() {
-	*x = DropAction{}
+func (x *ClientMessage) Reset() {
+	*x = ClientMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[11]
+		mi := &file_gameserver_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DropAction) String() string {
+func (x *ClientMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DropAction) ProtoMessage() {}
+func (*ClientMessage) ProtoMessage() {}
 
-func (x *DropAction) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[11]
+func (x *ClientMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1060,41 +2557,85 @@ func (x *DropAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DropAction.ProtoReflect.Descriptor instead.
-func (*DropAction) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
+func (*ClientMessage) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DropAction) GetSlot() EquipmentItemType {
+func (m *ClientMessage) GetMessage() isClientMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetAction() *Action {
+	if x, ok := x.GetMessage().(*ClientMessage_Action); ok {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetNotification() *Notification {
+	if x, ok := x.GetMessage().(*ClientMessage_Notification); ok {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *ClientMessage) GetSequence() uint32 {
 	if x != nil {
-		return x.Slot
+		return x.Sequence
 	}
-	return EquipmentItemType_HELMET
+	return 0
 }
 
-type AttackAction struct {
+type isClientMessage_Message interface {
+	isClientMessage_Message()
+}
+
+type ClientMessage_Action struct {
+	Action *Action `protobuf:"bytes,1,opt,name=action,proto3,oneof"`
+}
+
+type ClientMessage_Notification struct {
+	Notification *Notification `protobuf:"bytes,2,opt,name=notification,proto3,oneof"`
+}
+
+func (*ClientMessage_Action) isClientMessage_Message() {}
+
+func (*ClientMessage_Notification) isClientMessage_Message() {}
+
+type ServerNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Type         ServerNotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.ServerNotificationType" json:"type,omitempty"`
+	Actor        string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Receiver     string                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	CooldownLeft float32                `protobuf:"fixed32,4,opt,name=cooldown_left,json=cooldownLeft,proto3" json:"cooldown_left,omitempty"`
+	MapId        string                 `protobuf:"bytes,5,opt,name=map_id,json=mapId,proto3" json:"map_id,omitempty"`
+	Hit          *HitEvent              `protobuf:"bytes,6,opt,name=hit,proto3" json:"hit,omitempty"`
 }
 
-func (x *AttackAction) Reset() {
-	*x = AttackAction{}
+func (x *ServerNotification) Reset() {
+	*x = ServerNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[12]
+		mi := &file_gameserver_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AttackAction) String() string {
+func (x *ServerNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttackAction) ProtoMessage() {}
+func (*ServerNotification) ProtoMessage() {}
 
-func (x *AttackAction) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[12]
+func (x *ServerNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1105,38 +2646,88 @@ func (x *AttackAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttackAction.ProtoReflect.Descriptor instead.
-func (*AttackAction) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ServerNotification.ProtoReflect.Descriptor instead.
+func (*ServerNotification) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{27}
 }
 
-type ConnectRequest struct {
+func (x *ServerNotification) GetType() ServerNotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return ServerNotificationType_PLAYER_CONNECTED
+}
+
+func (x *ServerNotification) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ServerNotification) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *ServerNotification) GetCooldownLeft() float32 {
+	if x != nil {
+		return x.CooldownLeft
+	}
+	return 0
+}
+
+func (x *ServerNotification) GetMapId() string {
+	if x != nil {
+		return x.MapId
+	}
+	return ""
+}
+
+func (x *ServerNotification) GetHit() *HitEvent {
+	if x != nil {
+		return x.Hit
+	}
+	return nil
+}
+
+type HitEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string               `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LocalTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`
-	Nickname  string               `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-}
-
-func (x *ConnectRequest) Reset() {
-	*x = ConnectRequest{}
+	AttackerId      int32   `protobuf:"varint,1,opt,name=attacker_id,json=attackerId,proto3" json:"attacker_id,omitempty"`
+	DefenderId      int32   `protobuf:"varint,2,opt,name=defender_id,json=defenderId,proto3" json:"defender_id,omitempty"`
+	AttackPower     int32   `protobuf:"varint,3,opt,name=attack_power,json=attackPower,proto3" json:"attack_power,omitempty"`
+	CritMultiplier  float32 `protobuf:"fixed32,4,opt,name=crit_multiplier,json=critMultiplier,proto3" json:"crit_multiplier,omitempty"`
+	Zone            HitZone `protobuf:"varint,5,opt,name=zone,proto3,enum=gameserver.HitZone" json:"zone,omitempty"`
+	ZoneMultiplier  float32 `protobuf:"fixed32,6,opt,name=zone_multiplier,json=zoneMultiplier,proto3" json:"zone_multiplier,omitempty"`
+	Armor           int32   `protobuf:"varint,7,opt,name=armor,proto3" json:"armor,omitempty"`
+	ArmorMitigated  float32 `protobuf:"fixed32,8,opt,name=armor_mitigated,json=armorMitigated,proto3" json:"armor_mitigated,omitempty"`
+	BlockMultiplier float32 `protobuf:"fixed32,9,opt,name=block_multiplier,json=blockMultiplier,proto3" json:"block_multiplier,omitempty"`
+	WallSlamDamage  int32   `protobuf:"varint,10,opt,name=wall_slam_damage,json=wallSlamDamage,proto3" json:"wall_slam_damage,omitempty"`
+	Damage          int32   `protobuf:"varint,11,opt,name=damage,proto3" json:"damage,omitempty"`
+}
+
+func (x *HitEvent) Reset() {
+	*x = HitEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[13]
+		mi := &file_gameserver_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ConnectRequest) String() string {
+func (x *HitEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectRequest) ProtoMessage() {}
+func (*HitEvent) ProtoMessage() {}
 
-func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[13]
+func (x *HitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,120 +2738,117 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
-func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use HitEvent.ProtoReflect.Descriptor instead.
+func (*HitEvent) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ConnectRequest) GetUserId() string {
+func (x *HitEvent) GetAttackerId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.AttackerId
 	}
-	return ""
+	return 0
 }
 
-func (x *ConnectRequest) GetLocalTime() *timestamp.Timestamp {
+func (x *HitEvent) GetDefenderId() int32 {
 	if x != nil {
-		return x.LocalTime
+		return x.DefenderId
 	}
-	return nil
+	return 0
 }
 
-func (x *ConnectRequest) GetNickname() string {
+func (x *HitEvent) GetAttackPower() int32 {
 	if x != nil {
-		return x.Nickname
+		return x.AttackPower
 	}
-	return ""
+	return 0
 }
 
-type ConnectResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ping       int32                `protobuf:"varint,1,opt,name=ping,proto3" json:"ping,omitempty"`
-	Token      string               `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	ServerTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+func (x *HitEvent) GetCritMultiplier() float32 {
+	if x != nil {
+		return x.CritMultiplier
+	}
+	return 0
 }
 
-func (x *ConnectResponse) Reset() {
-	*x = ConnectResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *HitEvent) GetZone() HitZone {
+	if x != nil {
+		return x.Zone
 	}
+	return HitZone_BODY
 }
 
-func (x *ConnectResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HitEvent) GetZoneMultiplier() float32 {
+	if x != nil {
+		return x.ZoneMultiplier
+	}
+	return 0
 }
 
-func (*ConnectResponse) ProtoMessage() {}
-
-func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *HitEvent) GetArmor() int32 {
+	if x != nil {
+		return x.Armor
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
-func (*ConnectResponse) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{14}
+func (x *HitEvent) GetArmorMitigated() float32 {
+	if x != nil {
+		return x.ArmorMitigated
+	}
+	return 0
 }
 
-func (x *ConnectResponse) GetPing() int32 {
+func (x *HitEvent) GetBlockMultiplier() float32 {
 	if x != nil {
-		return x.Ping
+		return x.BlockMultiplier
 	}
 	return 0
 }
 
-func (x *ConnectResponse) GetToken() string {
+func (x *HitEvent) GetWallSlamDamage() int32 {
 	if x != nil {
-		return x.Token
+		return x.WallSlamDamage
 	}
-	return ""
+	return 0
 }
 
-func (x *ConnectResponse) GetServerTime() *timestamp.Timestamp {
+func (x *HitEvent) GetDamage() int32 {
 	if x != nil {
-		return x.ServerTime
+		return x.Damage
 	}
-	return nil
+	return 0
 }
 
-type Notification struct {
+type ServerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type NotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.NotificationType" json:"type,omitempty"`
+	// Types that are assignable to Info:
+	//	*ServerResponse_Notification
+	//	*ServerResponse_GameState
+	Info       isServerResponse_Info `protobuf_oneof:"info"`
+	ServerTime *timestamp.Timestamp  `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *ServerResponse) Reset() {
+	*x = ServerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[15]
+		mi := &file_gameserver_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Notification) String() string {
+func (x *ServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*ServerResponse) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[15]
+func (x *ServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,46 +2859,87 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ServerResponse.ProtoReflect.Descriptor instead.
+func (*ServerResponse) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Notification) GetType() NotificationType {
+func (m *ServerResponse) GetInfo() isServerResponse_Info {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetNotification() *ServerNotification {
+	if x, ok := x.GetInfo().(*ServerResponse_Notification); ok {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetGameState() *GameState {
+	if x, ok := x.GetInfo().(*ServerResponse_GameState); ok {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetServerTime() *timestamp.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.ServerTime
 	}
-	return NotificationType_CONNECT
+	return nil
 }
 
-type ClientMessage struct {
+type isServerResponse_Info interface {
+	isServerResponse_Info()
+}
+
+type ServerResponse_Notification struct {
+	Notification *ServerNotification `protobuf:"bytes,1,opt,name=notification,proto3,oneof"`
+}
+
+type ServerResponse_GameState struct {
+	GameState *GameState `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3,oneof"`
+}
+
+func (*ServerResponse_Notification) isServerResponse_Info() {}
+
+func (*ServerResponse_GameState) isServerResponse_Info() {}
+
+type ReplayHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Message:
-	//	*ClientMessage_Action
-	//	*ClientMessage_Notification
-	Message isClientMessage_Message `protobuf_oneof:"message"`
+	Version          int32          `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Map              []byte         `protobuf:"bytes,2,opt,name=map,proto3" json:"map,omitempty"`
+	Config           []byte         `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Seed             int64          `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	DefaultWeapon    *EquipmentItem `protobuf:"bytes,5,opt,name=default_weapon,json=defaultWeapon,proto3" json:"default_weapon,omitempty"`
+	Nicknames        []string       `protobuf:"bytes,6,rep,name=nicknames,proto3" json:"nicknames,omitempty"`
+	UserIds          []string       `protobuf:"bytes,7,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	KeyframeInterval int32          `protobuf:"varint,8,opt,name=keyframe_interval,json=keyframeInterval,proto3" json:"keyframe_interval,omitempty"`
 }
 
-func (x *ClientMessage) Reset() {
-	*x = ClientMessage{}
+func (x *ReplayHeader) Reset() {
+	*x = ReplayHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[16]
+		mi := &file_gameserver_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClientMessage) String() string {
+func (x *ReplayHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClientMessage) ProtoMessage() {}
+func (*ReplayHeader) ProtoMessage() {}
 
-func (x *ClientMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[16]
+func (x *ReplayHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,75 +2950,95 @@ func (x *ClientMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClientMessage.ProtoReflect.Descriptor instead.
-func (*ClientMessage) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ReplayHeader.ProtoReflect.Descriptor instead.
+func (*ReplayHeader) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{30}
 }
 
-func (m *ClientMessage) GetMessage() isClientMessage_Message {
-	if m != nil {
-		return m.Message
+func (x *ReplayHeader) GetVersion() int32 {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *ClientMessage) GetAction() *Action {
-	if x, ok := x.GetMessage().(*ClientMessage_Action); ok {
-		return x.Action
+func (x *ReplayHeader) GetMap() []byte {
+	if x != nil {
+		return x.Map
 	}
 	return nil
 }
 
-func (x *ClientMessage) GetNotification() *Notification {
-	if x, ok := x.GetMessage().(*ClientMessage_Notification); ok {
-		return x.Notification
+func (x *ReplayHeader) GetConfig() []byte {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-type isClientMessage_Message interface {
-	isClientMessage_Message()
+func (x *ReplayHeader) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
 }
 
-type ClientMessage_Action struct {
-	Action *Action `protobuf:"bytes,1,opt,name=action,proto3,oneof"`
+func (x *ReplayHeader) GetDefaultWeapon() *EquipmentItem {
+	if x != nil {
+		return x.DefaultWeapon
+	}
+	return nil
 }
 
-type ClientMessage_Notification struct {
-	Notification *Notification `protobuf:"bytes,2,opt,name=notification,proto3,oneof"`
+func (x *ReplayHeader) GetNicknames() []string {
+	if x != nil {
+		return x.Nicknames
+	}
+	return nil
 }
 
-func (*ClientMessage_Action) isClientMessage_Message() {}
+func (x *ReplayHeader) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
 
-func (*ClientMessage_Notification) isClientMessage_Message() {}
+func (x *ReplayHeader) GetKeyframeInterval() int32 {
+	if x != nil {
+		return x.KeyframeInterval
+	}
+	return 0
+}
 
-type ServerNotification struct {
+type ReplayInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type     ServerNotificationType `protobuf:"varint,1,opt,name=type,proto3,enum=gameserver.ServerNotificationType" json:"type,omitempty"`
-	Actor    string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
-	Receiver string                 `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	PlayerId    int32   `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Action      *Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	RewindTicks int32   `protobuf:"varint,3,opt,name=rewind_ticks,json=rewindTicks,proto3" json:"rewind_ticks,omitempty"`
+	Sequence    uint32  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (x *ServerNotification) Reset() {
-	*x = ServerNotification{}
+func (x *ReplayInput) Reset() {
+	*x = ReplayInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[17]
+		mi := &file_gameserver_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerNotification) String() string {
+func (x *ReplayInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerNotification) ProtoMessage() {}
+func (*ReplayInput) ProtoMessage() {}
 
-func (x *ServerNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[17]
+func (x *ReplayInput) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1400,61 +3049,66 @@ func (x *ServerNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerNotification.ProtoReflect.Descriptor instead.
-func (*ServerNotification) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ReplayInput.ProtoReflect.Descriptor instead.
+func (*ReplayInput) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ServerNotification) GetType() ServerNotificationType {
+func (x *ReplayInput) GetPlayerId() int32 {
 	if x != nil {
-		return x.Type
+		return x.PlayerId
 	}
-	return ServerNotificationType_PLAYER_CONNECTED
+	return 0
 }
 
-func (x *ServerNotification) GetActor() string {
+func (x *ReplayInput) GetAction() *Action {
 	if x != nil {
-		return x.Actor
+		return x.Action
 	}
-	return ""
+	return nil
 }
 
-func (x *ServerNotification) GetReceiver() string {
+func (x *ReplayInput) GetRewindTicks() int32 {
 	if x != nil {
-		return x.Receiver
+		return x.RewindTicks
 	}
-	return ""
+	return 0
 }
 
-type ServerResponse struct {
+func (x *ReplayInput) GetSequence() uint32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type ReplayTick struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Info:
-	//	*ServerResponse_Notification
-	//	*ServerResponse_GameState
-	Info       isServerResponse_Info `protobuf_oneof:"info"`
-	ServerTime *timestamp.Timestamp  `protobuf:"bytes,3,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`
+	Tick     int64          `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty"`
+	Inputs   []*ReplayInput `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Keyframe *GameState     `protobuf:"bytes,3,opt,name=keyframe,proto3" json:"keyframe,omitempty"`
 }
 
-func (x *ServerResponse) Reset() {
-	*x = ServerResponse{}
+func (x *ReplayTick) Reset() {
+	*x = ReplayTick{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gameserver_proto_msgTypes[18]
+		mi := &file_gameserver_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerResponse) String() string {
+func (x *ReplayTick) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerResponse) ProtoMessage() {}
+func (*ReplayTick) ProtoMessage() {}
 
-func (x *ServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gameserver_proto_msgTypes[18]
+func (x *ReplayTick) ProtoReflect() protoreflect.Message {
+	mi := &file_gameserver_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1465,55 +3119,32 @@ func (x *ServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerResponse.ProtoReflect.Descriptor instead.
-func (*ServerResponse) Descriptor() ([]byte, []int) {
-	return file_gameserver_proto_rawDescGZIP(), []int{18}
-}
-
-func (m *ServerResponse) GetInfo() isServerResponse_Info {
-	if m != nil {
-		return m.Info
-	}
-	return nil
+// Deprecated: Use ReplayTick.ProtoReflect.Descriptor instead.
+func (*ReplayTick) Descriptor() ([]byte, []int) {
+	return file_gameserver_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *ServerResponse) GetNotification() *ServerNotification {
-	if x, ok := x.GetInfo().(*ServerResponse_Notification); ok {
-		return x.Notification
+func (x *ReplayTick) GetTick() int64 {
+	if x != nil {
+		return x.Tick
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerResponse) GetGameState() *GameState {
-	if x, ok := x.GetInfo().(*ServerResponse_GameState); ok {
-		return x.GameState
+func (x *ReplayTick) GetInputs() []*ReplayInput {
+	if x != nil {
+		return x.Inputs
 	}
 	return nil
 }
 
-func (x *ServerResponse) GetServerTime() *timestamp.Timestamp {
+func (x *ReplayTick) GetKeyframe() *GameState {
 	if x != nil {
-		return x.ServerTime
+		return x.Keyframe
 	}
 	return nil
 }
 
-type isServerResponse_Info interface {
-	isServerResponse_Info()
-}
-
-type ServerResponse_Notification struct {
-	Notification *ServerNotification `protobuf:"bytes,1,opt,name=notification,proto3,oneof"`
-}
-
-type ServerResponse_GameState struct {
-	GameState *GameState `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3,oneof"`
-}
-
-func (*ServerResponse_Notification) isServerResponse_Info() {}
-
-func (*ServerResponse_GameState) isServerResponse_Info() {}
-
 var File_gameserver_proto protoreflect.FileDescriptor
 
 var file_gameserver_proto_rawDesc = []byte{
@@ -1521,7 +3152,7 @@ var file_gameserver_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x1a, 0x1f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x9a, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0xc7, 0x03, 0x0a, 0x15, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63,
 	0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74,
 	0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
@@ -1530,192 +3161,465 @@ var file_gameserver_proto_rawDesc = []byte{
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x43,
 	0x6f, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6b, 0x6e, 0x6f, 0x63, 0x6b, 0x62, 0x61, 0x63, 0x6b,
 	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x6b, 0x6e,
-	0x6f, 0x63, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0xb7, 0x02, 0x0a,
-	0x0d, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x31,
-	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
-	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x61, 0x72, 0x69,
-	0x74, 0x79, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x77, 0x65,
-	0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x65,
-	0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x72, 0x73, 0x12, 0x19, 0x0a, 0x07, 0x68, 0x70, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x68, 0x70, 0x42, 0x75, 0x66, 0x66, 0x12, 0x2b, 0x0a,
-	0x10, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74,
-	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65,
-	0x6d, 0x49, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e,
-	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d,
-	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xa8, 0x01,
-	0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x31, 0x0a, 0x06, 0x68, 0x65, 0x6c, 0x6d, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x6f, 0x63, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6c, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x6d, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x61,
+	0x6d, 0x6d, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x64, 0x5f, 0x75, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x55, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x6c,
+	0x61, 0x6d, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x61, 0x6d, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x48, 0x0a, 0x0e, 0x6f, 0x6e, 0x5f, 0x68, 0x69, 0x74, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x6e, 0x48,
+	0x69, 0x74, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x16, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x54, 0x69, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x77, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x69, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x22, 0x92, 0x01, 0x0a,
+	0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x30, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x82, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68,
+	0x65, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x0d, 0x45, 0x71, 0x75, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x72,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52,
+	0x0b, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x07,
+	0x68, 0x70, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x06, 0x68, 0x70, 0x42, 0x75, 0x66, 0x66, 0x12, 0x2b, 0x0a, 0x10, 0x64, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x64, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x0f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x64, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x52, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45,
+	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2e, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xe5, 0x01, 0x0a, 0x0f,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x06, 0x68, 0x65, 0x6c, 0x6d, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75,
+	0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x68, 0x65, 0x6c, 0x6d,
+	0x65, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
-	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x68, 0x65,
-	0x6c, 0x6d, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x61, 0x72,
+	0x6d, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
-	0x61, 0x72, 0x6d, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x06, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x06, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78,
-	0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0x3b,
-	0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64,
-	0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x06,
-	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02,
-	0x68, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64,
-	0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x64,
-	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x22, 0xdb,
-	0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f, 0x76,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12,
-	0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x69,
-	0x63, 0x6b, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x70, 0x69,
-	0x63, 0x6b, 0x55, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x44, 0x72, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x64, 0x72,
-	0x6f, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0e,
-	0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
-	0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x22, 0x27,
-	0x0a, 0x0c, 0x50, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x3f, 0x0a, 0x0a, 0x44, 0x72, 0x6f, 0x70, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x0e, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x78, 0x0a, 0x0f, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x69,
-	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06,
+	0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x06, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0c, 0x0a,
+	0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x79, 0x22, 0x53, 0x0a, 0x0b, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x65, 0x61, 0x74, 0x68, 0x73, 0x22, 0xd5,
+	0x06, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x02, 0x68, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x45, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x2a, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6c, 0x65, 0x66, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x6d, 0x69, 0x6e, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x73, 0x74,
+	0x61, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x2e, 0x0a,
+	0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x08, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x6e, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6b, 0x6e,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x44, 0x6f, 0x77, 0x6e, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x76,
+	0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4c,
+	0x65, 0x66, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x12,
+	0x32, 0x0a, 0x15, 0x73, 0x70, 0x61, 0x77, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13,
+	0x73, 0x70, 0x61, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x65, 0x66, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x08, 0x53, 0x61, 0x66, 0x65, 0x5a,
+	0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x06, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x22, 0x92, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x09, 0x4d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x02, 0x68, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x22, 0x86, 0x03, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x61, 0x6d, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x71,
+	0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x31, 0x0a, 0x09,
+	0x73, 0x61, 0x66, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x61, 0x66,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x52, 0x08, 0x73, 0x61, 0x66, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12,
+	0x38, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x6d, 0x61, 0x70,
+	0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x5f, 0x6c, 0x65, 0x66,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x4c, 0x65,
+	0x66, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x65, 0x66, 0x74, 0x22,
+	0xb2, 0x03, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x06,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x33, 0x0a, 0x07, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x50,
+	0x69, 0x63, 0x6b, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x70,
+	0x69, 0x63, 0x6b, 0x55, 0x70, 0x12, 0x2c, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x44, 0x72, 0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x64,
+	0x72, 0x6f, 0x70, 0x12, 0x36, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x38, 0x0a, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x76, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x76, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x76, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x70, 0x72, 0x69, 0x6e,
+	0x74, 0x22, 0x27, 0x0a, 0x0c, 0x50, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x0a, 0x44, 0x72,
+	0x6f, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x22, 0x28, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x0e,
+	0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x25,
+	0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x2d, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x76, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x80, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x7e, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x67,
-	0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61,
+	0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe2, 0x01, 0x0a,
+	0x12, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x4c, 0x65,
+	0x66, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x70, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x68, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x03, 0x68, 0x69,
+	0x74, 0x22, 0x96, 0x03, 0x0a, 0x08, 0x48, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x69, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x63, 0x72,
+	0x69, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x67, 0x61, 0x6d,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x69, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x52,
+	0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e,
+	0x7a, 0x6f, 0x6e, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x72, 0x6d, 0x6f, 0x72, 0x5f, 0x6d, 0x69,
+	0x74, 0x69, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x61,
+	0x72, 0x6d, 0x6f, 0x72, 0x4d, 0x69, 0x74, 0x69, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c,
+	0x5f, 0x73, 0x6c, 0x61, 0x6d, 0x5f, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x61, 0x6d, 0x44, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00,
+	0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x22, 0x8e, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d,
+	0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x77, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x45,
+	0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0d, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x57, 0x65, 0x61, 0x70, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x70, 0x75,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x77, 0x69, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x72, 0x65, 0x77, 0x69, 0x6e, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x12, 0x2f, 0x0a, 0x06,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x31, 0x0a,
+	0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x47, 0x61, 0x6d,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
-	0x42, 0x06, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x2a, 0x36, 0x0a, 0x11, 0x45, 0x71, 0x75, 0x69,
-	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
-	0x06, 0x48, 0x45, 0x4c, 0x4d, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d,
-	0x4f, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x45, 0x41, 0x50, 0x4f, 0x4e, 0x10, 0x02,
-	0x2a, 0x5f, 0x0a, 0x13, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55,
-	0x4c, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x01,
-	0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x52, 0x41, 0x52, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x50, 0x49, 0x43,
-	0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45, 0x47, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x59, 0x10,
-	0x05, 0x2a, 0x2f, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x10, 0x01, 0x2a, 0x94, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
-	0x10, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x49,
-	0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x13, 0x0a, 0x0f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b,
-	0x45, 0x44, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x46,
-	0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x05, 0x32, 0x98, 0x01, 0x0a, 0x0b, 0x47, 0x61,
-	0x6d, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x43, 0x0a, 0x04, 0x54, 0x61, 0x6c, 0x6b, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x28, 0x01, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x61, 0x6d, 0x69, 0x6b, 0x68, 0x61, 0x69, 0x6c, 0x61, 0x75, 0x2f, 0x6d, 0x65,
-	0x64, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x2d, 0x67, 0x61, 0x6d, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x2a, 0x46, 0x0a, 0x11, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x48, 0x45, 0x4c, 0x4d, 0x45, 0x54, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52, 0x4d, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06,
+	0x57, 0x45, 0x41, 0x50, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x53,
+	0x55, 0x4d, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x2a, 0x5f, 0x0a, 0x13, 0x45, 0x71, 0x75, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x43, 0x4f, 0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x55, 0x4e, 0x43, 0x4f,
+	0x4d, 0x4d, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x41, 0x52, 0x45, 0x10, 0x03,
+	0x12, 0x08, 0x0a, 0x04, 0x45, 0x50, 0x49, 0x43, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x45,
+	0x47, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x59, 0x10, 0x05, 0x2a, 0x29, 0x0a, 0x0e, 0x43, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x42,
+	0x41, 0x4e, 0x44, 0x41, 0x47, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x4f, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x44, 0x4f, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x52,
+	0x52, 0x49, 0x43, 0x41, 0x44, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x03, 0x2a, 0x3b, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x45, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x55, 0x4e, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x53, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x03,
+	0x2a, 0x3a, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x46, 0x52,
+	0x45, 0x53, 0x48, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x43, 0x4b, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x27, 0x0a, 0x07,
+	0x48, 0x69, 0x74, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x44, 0x59, 0x10,
+	0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42,
+	0x41, 0x43, 0x4b, 0x10, 0x02, 0x2a, 0x39, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e,
+	0x4e, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4e, 0x47, 0x10, 0x02,
+	0x2a, 0xc3, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x50,
+	0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x49, 0x53, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x4c,
+	0x41, 0x59, 0x45, 0x52, 0x5f, 0x4b, 0x49, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a,
+	0x0f, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4e,
+	0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x54, 0x54, 0x41, 0x43,
+	0x4b, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
+	0x50, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4c, 0x41, 0x59, 0x45, 0x52,
+	0x5f, 0x48, 0x49, 0x54, 0x10, 0x08, 0x32, 0x98, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x4d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x04,
+	0x54, 0x61, 0x6c, 0x6b, 0x12, 0x19, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
+	0x1a, 0x2e, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6d, 0x69, 0x6b, 0x68, 0x61, 0x69, 0x6c, 0x61, 0x75, 0x2f, 0x6d, 0x65, 0x64, 0x69, 0x65,
+	0x76, 0x61, 0x6c, 0x2d, 0x67, 0x61, 0x6d, 0x65, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1730,72 +3634,120 @@ func file_gameserver_proto_rawDescGZIP() []byte {
 	return file_gameserver_proto_rawDescData
 }
 
-var file_gameserver_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_gameserver_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_gameserver_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_gameserver_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_gameserver_proto_goTypes = []interface{}{
-	(EquipmentItemType)(0),        // 0: gameserver.EquipmentItemType
-	(EquipmentItemRarity)(0),      // 1: gameserver.EquipmentItemRarity
-	(NotificationType)(0),         // 2: gameserver.NotificationType
-	(ServerNotificationType)(0),   // 3: gameserver.ServerNotificationType
-	(*WeaponCharacteristics)(nil), // 4: gameserver.WeaponCharacteristics
-	(*EquipmentItem)(nil),         // 5: gameserver.EquipmentItem
-	(*DroppedEquipmentItem)(nil),  // 6: gameserver.DroppedEquipmentItem
-	(*PlayerEquipment)(nil),       // 7: gameserver.PlayerEquipment
-	(*Vector)(nil),                // 8: gameserver.Vector
-	(*PlayerStats)(nil),           // 9: gameserver.PlayerStats
-	(*Player)(nil),                // 10: gameserver.Player
-	(*GameState)(nil),             // 11: gameserver.GameState
-	(*Action)(nil),                // 12: gameserver.Action
-	(*MovementAction)(nil),        // 13: gameserver.MovementAction
-	(*PickUpAction)(nil),          // 14: gameserver.PickUpAction
-	(*DropAction)(nil),            // 15: gameserver.DropAction
-	(*AttackAction)(nil),          // 16: gameserver.AttackAction
-	(*ConnectRequest)(nil),        // 17: gameserver.ConnectRequest
-	(*ConnectResponse)(nil),       // 18: gameserver.ConnectResponse
-	(*Notification)(nil),          // 19: gameserver.Notification
-	(*ClientMessage)(nil),         // 20: gameserver.ClientMessage
-	(*ServerNotification)(nil),    // 21: gameserver.ServerNotification
-	(*ServerResponse)(nil),        // 22: gameserver.ServerResponse
-	(*timestamp.Timestamp)(nil),   // 23: google.protobuf.Timestamp
+	(EquipmentItemType)(0),            // 0: gameserver.EquipmentItemType
+	(EquipmentItemRarity)(0),          // 1: gameserver.EquipmentItemRarity
+	(ConsumableType)(0),               // 2: gameserver.ConsumableType
+	(MapEntityType)(0),                // 3: gameserver.MapEntityType
+	(StatusEffectType)(0),             // 4: gameserver.StatusEffectType
+	(StatusEffectStacking)(0),         // 5: gameserver.StatusEffectStacking
+	(HitZone)(0),                      // 6: gameserver.HitZone
+	(NotificationType)(0),             // 7: gameserver.NotificationType
+	(ServerNotificationType)(0),       // 8: gameserver.ServerNotificationType
+	(*WeaponCharacteristics)(nil),     // 9: gameserver.WeaponCharacteristics
+	(*StatusEffectDefinition)(nil),    // 10: gameserver.StatusEffectDefinition
+	(*StatusEffect)(nil),              // 11: gameserver.StatusEffect
+	(*ConsumableCharacteristics)(nil), // 12: gameserver.ConsumableCharacteristics
+	(*EquipmentItem)(nil),             // 13: gameserver.EquipmentItem
+	(*DroppedEquipmentItem)(nil),      // 14: gameserver.DroppedEquipmentItem
+	(*PlayerEquipment)(nil),           // 15: gameserver.PlayerEquipment
+	(*Vector)(nil),                    // 16: gameserver.Vector
+	(*PlayerStats)(nil),               // 17: gameserver.PlayerStats
+	(*Player)(nil),                    // 18: gameserver.Player
+	(*SafeZone)(nil),                  // 19: gameserver.SafeZone
+	(*Projectile)(nil),                // 20: gameserver.Projectile
+	(*MapEntity)(nil),                 // 21: gameserver.MapEntity
+	(*GameState)(nil),                 // 22: gameserver.GameState
+	(*Action)(nil),                    // 23: gameserver.Action
+	(*MovementAction)(nil),            // 24: gameserver.MovementAction
+	(*PickUpAction)(nil),              // 25: gameserver.PickUpAction
+	(*DropAction)(nil),                // 26: gameserver.DropAction
+	(*UseItemAction)(nil),             // 27: gameserver.UseItemAction
+	(*AttackAction)(nil),              // 28: gameserver.AttackAction
+	(*BlockAction)(nil),               // 29: gameserver.BlockAction
+	(*InteractAction)(nil),            // 30: gameserver.InteractAction
+	(*ReviveAction)(nil),              // 31: gameserver.ReviveAction
+	(*ConnectRequest)(nil),            // 32: gameserver.ConnectRequest
+	(*ConnectResponse)(nil),           // 33: gameserver.ConnectResponse
+	(*Notification)(nil),              // 34: gameserver.Notification
+	(*ClientMessage)(nil),             // 35: gameserver.ClientMessage
+	(*ServerNotification)(nil),        // 36: gameserver.ServerNotification
+	(*HitEvent)(nil),                  // 37: gameserver.HitEvent
+	(*ServerResponse)(nil),            // 38: gameserver.ServerResponse
+	(*ReplayHeader)(nil),              // 39: gameserver.ReplayHeader
+	(*ReplayInput)(nil),               // 40: gameserver.ReplayInput
+	(*ReplayTick)(nil),                // 41: gameserver.ReplayTick
+	(*timestamp.Timestamp)(nil),       // 42: google.protobuf.Timestamp
 }
 var file_gameserver_proto_depIdxs = []int32{
-	0,  // 0: gameserver.EquipmentItem.type:type_name -> gameserver.EquipmentItemType
-	1,  // 1: gameserver.EquipmentItem.rarity:type_name -> gameserver.EquipmentItemRarity
-	4,  // 2: gameserver.EquipmentItem.weapon_chars:type_name -> gameserver.WeaponCharacteristics
-	8,  // 3: gameserver.DroppedEquipmentItem.position:type_name -> gameserver.Vector
-	5,  // 4: gameserver.DroppedEquipmentItem.item:type_name -> gameserver.EquipmentItem
-	5,  // 5: gameserver.PlayerEquipment.helmet:type_name -> gameserver.EquipmentItem
-	5,  // 6: gameserver.PlayerEquipment.armor:type_name -> gameserver.EquipmentItem
-	5,  // 7: gameserver.PlayerEquipment.weapon:type_name -> gameserver.EquipmentItem
-	7,  // 8: gameserver.Player.equipment:type_name -> gameserver.PlayerEquipment
-	8,  // 9: gameserver.Player.position:type_name -> gameserver.Vector
-	9,  // 10: gameserver.Player.stats:type_name -> gameserver.PlayerStats
-	10, // 11: gameserver.GameState.players:type_name -> gameserver.Player
-	6,  // 12: gameserver.GameState.dropped_items:type_name -> gameserver.DroppedEquipmentItem
-	13, // 13: gameserver.Action.move:type_name -> gameserver.MovementAction
-	16, // 14: gameserver.Action.attack:type_name -> gameserver.AttackAction
-	14, // 15: gameserver.Action.pick_up:type_name -> gameserver.PickUpAction
-	15, // 16: gameserver.Action.drop:type_name -> gameserver.DropAction
-	8,  // 17: gameserver.MovementAction.shift:type_name -> gameserver.Vector
-	0,  // 18: gameserver.DropAction.slot:type_name -> gameserver.EquipmentItemType
-	23, // 19: gameserver.ConnectRequest.local_time:type_name -> google.protobuf.Timestamp
-	23, // 20: gameserver.ConnectResponse.server_time:type_name -> google.protobuf.Timestamp
-	2,  // 21: gameserver.Notification.type:type_name -> gameserver.NotificationType
-	12, // 22: gameserver.ClientMessage.action:type_name -> gameserver.Action
-	19, // 23: gameserver.ClientMessage.notification:type_name -> gameserver.Notification
-	3,  // 24: gameserver.ServerNotification.type:type_name -> gameserver.ServerNotificationType
-	21, // 25: gameserver.ServerResponse.notification:type_name -> gameserver.ServerNotification
-	11, // 26: gameserver.ServerResponse.game_state:type_name -> gameserver.GameState
-	23, // 27: gameserver.ServerResponse.server_time:type_name -> google.protobuf.Timestamp
-	17, // 28: gameserver.GameManager.Connect:input_type -> gameserver.ConnectRequest
-	20, // 29: gameserver.GameManager.Talk:input_type -> gameserver.ClientMessage
-	18, // 30: gameserver.GameManager.Connect:output_type -> gameserver.ConnectResponse
-	22, // 31: gameserver.GameManager.Talk:output_type -> gameserver.ServerResponse
-	30, // [30:32] is the sub-list for method output_type
-	28, // [28:30] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	10, // 0: gameserver.WeaponCharacteristics.on_hit_effects:type_name -> gameserver.StatusEffectDefinition
+	4,  // 1: gameserver.StatusEffectDefinition.type:type_name -> gameserver.StatusEffectType
+	5,  // 2: gameserver.StatusEffectDefinition.stacking:type_name -> gameserver.StatusEffectStacking
+	4,  // 3: gameserver.StatusEffect.type:type_name -> gameserver.StatusEffectType
+	2,  // 4: gameserver.ConsumableCharacteristics.type:type_name -> gameserver.ConsumableType
+	0,  // 5: gameserver.EquipmentItem.type:type_name -> gameserver.EquipmentItemType
+	1,  // 6: gameserver.EquipmentItem.rarity:type_name -> gameserver.EquipmentItemRarity
+	9,  // 7: gameserver.EquipmentItem.weapon_chars:type_name -> gameserver.WeaponCharacteristics
+	12, // 8: gameserver.EquipmentItem.consumable_chars:type_name -> gameserver.ConsumableCharacteristics
+	16, // 9: gameserver.DroppedEquipmentItem.position:type_name -> gameserver.Vector
+	13, // 10: gameserver.DroppedEquipmentItem.item:type_name -> gameserver.EquipmentItem
+	13, // 11: gameserver.PlayerEquipment.helmet:type_name -> gameserver.EquipmentItem
+	13, // 12: gameserver.PlayerEquipment.armor:type_name -> gameserver.EquipmentItem
+	13, // 13: gameserver.PlayerEquipment.weapon:type_name -> gameserver.EquipmentItem
+	13, // 14: gameserver.PlayerEquipment.consumables:type_name -> gameserver.EquipmentItem
+	15, // 15: gameserver.Player.equipment:type_name -> gameserver.PlayerEquipment
+	16, // 16: gameserver.Player.position:type_name -> gameserver.Vector
+	17, // 17: gameserver.Player.stats:type_name -> gameserver.PlayerStats
+	13, // 18: gameserver.Player.channeling_item:type_name -> gameserver.EquipmentItem
+	16, // 19: gameserver.Player.velocity:type_name -> gameserver.Vector
+	11, // 20: gameserver.Player.status_effects:type_name -> gameserver.StatusEffect
+	16, // 21: gameserver.SafeZone.center:type_name -> gameserver.Vector
+	16, // 22: gameserver.SafeZone.target_center:type_name -> gameserver.Vector
+	16, // 23: gameserver.Projectile.position:type_name -> gameserver.Vector
+	3,  // 24: gameserver.MapEntity.type:type_name -> gameserver.MapEntityType
+	18, // 25: gameserver.GameState.players:type_name -> gameserver.Player
+	14, // 26: gameserver.GameState.dropped_items:type_name -> gameserver.DroppedEquipmentItem
+	19, // 27: gameserver.GameState.safe_zone:type_name -> gameserver.SafeZone
+	20, // 28: gameserver.GameState.projectiles:type_name -> gameserver.Projectile
+	21, // 29: gameserver.GameState.map_entities:type_name -> gameserver.MapEntity
+	24, // 30: gameserver.Action.move:type_name -> gameserver.MovementAction
+	28, // 31: gameserver.Action.attack:type_name -> gameserver.AttackAction
+	25, // 32: gameserver.Action.pick_up:type_name -> gameserver.PickUpAction
+	26, // 33: gameserver.Action.drop:type_name -> gameserver.DropAction
+	27, // 34: gameserver.Action.use_item:type_name -> gameserver.UseItemAction
+	29, // 35: gameserver.Action.block:type_name -> gameserver.BlockAction
+	30, // 36: gameserver.Action.interact:type_name -> gameserver.InteractAction
+	31, // 37: gameserver.Action.revive:type_name -> gameserver.ReviveAction
+	16, // 38: gameserver.MovementAction.shift:type_name -> gameserver.Vector
+	16, // 39: gameserver.MovementAction.direction:type_name -> gameserver.Vector
+	0,  // 40: gameserver.DropAction.slot:type_name -> gameserver.EquipmentItemType
+	42, // 41: gameserver.ConnectRequest.local_time:type_name -> google.protobuf.Timestamp
+	42, // 42: gameserver.ConnectResponse.server_time:type_name -> google.protobuf.Timestamp
+	7,  // 43: gameserver.Notification.type:type_name -> gameserver.NotificationType
+	42, // 44: gameserver.Notification.server_time:type_name -> google.protobuf.Timestamp
+	23, // 45: gameserver.ClientMessage.action:type_name -> gameserver.Action
+	34, // 46: gameserver.ClientMessage.notification:type_name -> gameserver.Notification
+	8,  // 47: gameserver.ServerNotification.type:type_name -> gameserver.ServerNotificationType
+	37, // 48: gameserver.ServerNotification.hit:type_name -> gameserver.HitEvent
+	6,  // 49: gameserver.HitEvent.zone:type_name -> gameserver.HitZone
+	36, // 50: gameserver.ServerResponse.notification:type_name -> gameserver.ServerNotification
+	22, // 51: gameserver.ServerResponse.game_state:type_name -> gameserver.GameState
+	42, // 52: gameserver.ServerResponse.server_time:type_name -> google.protobuf.Timestamp
+	13, // 53: gameserver.ReplayHeader.default_weapon:type_name -> gameserver.EquipmentItem
+	23, // 54: gameserver.ReplayInput.action:type_name -> gameserver.Action
+	40, // 55: gameserver.ReplayTick.inputs:type_name -> gameserver.ReplayInput
+	22, // 56: gameserver.ReplayTick.keyframe:type_name -> gameserver.GameState
+	32, // 57: gameserver.GameManager.Connect:input_type -> gameserver.ConnectRequest
+	35, // 58: gameserver.GameManager.Talk:input_type -> gameserver.ClientMessage
+	33, // 59: gameserver.GameManager.Connect:output_type -> gameserver.ConnectResponse
+	38, // 60: gameserver.GameManager.Talk:output_type -> gameserver.ServerResponse
+	59, // [59:61] is the sub-list for method output_type
+	57, // [57:59] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_gameserver_proto_init() }
@@ -1817,7 +3769,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EquipmentItem); i {
+			switch v := v.(*StatusEffectDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1829,7 +3781,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DroppedEquipmentItem); i {
+			switch v := v.(*StatusEffect); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1841,7 +3793,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerEquipment); i {
+			switch v := v.(*ConsumableCharacteristics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1853,7 +3805,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Vector); i {
+			switch v := v.(*EquipmentItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1865,7 +3817,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PlayerStats); i {
+			switch v := v.(*DroppedEquipmentItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1877,7 +3829,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Player); i {
+			switch v := v.(*PlayerEquipment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1889,7 +3841,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GameState); i {
+			switch v := v.(*Vector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1901,7 +3853,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Action); i {
+			switch v := v.(*PlayerStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1913,7 +3865,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MovementAction); i {
+			switch v := v.(*Player); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1925,7 +3877,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PickUpAction); i {
+			switch v := v.(*SafeZone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,7 +3889,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropAction); i {
+			switch v := v.(*Projectile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1949,7 +3901,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttackAction); i {
+			switch v := v.(*MapEntity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,7 +3913,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRequest); i {
+			switch v := v.(*GameState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,7 +3925,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectResponse); i {
+			switch v := v.(*Action); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,7 +3937,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Notification); i {
+			switch v := v.(*MovementAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,7 +3949,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClientMessage); i {
+			switch v := v.(*PickUpAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,7 +3961,7 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ServerNotification); i {
+			switch v := v.(*DropAction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,6 +3973,138 @@ func file_gameserver_proto_init() {
 			}
 		}
 		file_gameserver_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UseItemAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttackAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InteractAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviveAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HitEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ServerResponse); i {
 			case 0:
 				return &v.state
@@ -2032,23 +4116,64 @@ func file_gameserver_proto_init() {
 				return nil
 			}
 		}
+		file_gameserver_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gameserver_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayTick); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_gameserver_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_gameserver_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*EquipmentItem_WeaponChars)(nil),
 		(*EquipmentItem_HpBuff)(nil),
 		(*EquipmentItem_DamageReduction)(nil),
+		(*EquipmentItem_ConsumableChars)(nil),
 	}
-	file_gameserver_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_gameserver_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*Action_Move)(nil),
 		(*Action_Attack)(nil),
 		(*Action_PickUp)(nil),
 		(*Action_Drop)(nil),
+		(*Action_UseItem)(nil),
+		(*Action_Block)(nil),
+		(*Action_Interact)(nil),
+		(*Action_Revive)(nil),
 	}
-	file_gameserver_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_gameserver_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*ClientMessage_Action)(nil),
 		(*ClientMessage_Notification)(nil),
 	}
-	file_gameserver_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_gameserver_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*ServerResponse_Notification)(nil),
 		(*ServerResponse_GameState)(nil),
 	}
@@ -2057,8 +4182,8 @@ func file_gameserver_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gameserver_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   19,
+			NumEnums:      9,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
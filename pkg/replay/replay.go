@@ -0,0 +1,197 @@
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// maxMessageSize protects reader from allocating huge buffers for corrupted files
+const maxMessageSize = 64 << 20
+
+var ErrDesync = errors.New("simulation diverged from replay")
+
+// Writer stores replay as length-delimited protobuf messages, header goes first and then one message per tick
+type Writer struct {
+	file   *os.File
+	buffer *bufio.Writer
+}
+
+func Create(filename string, header *pb.ReplayHeader) (*Writer, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating replay file: %v", err)
+	}
+	w := &Writer{file: file, buffer: bufio.NewWriter(file)}
+	if err = writeMessage(w.buffer, header); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *Writer) WriteTick(tick *pb.ReplayTick) error {
+	return writeMessage(w.buffer, tick)
+}
+
+func (w *Writer) Close() error {
+	if err := w.buffer.Flush(); err != nil {
+		w.file.Close()
+		return fmt.Errorf("Error flushing replay file: %v", err)
+	}
+	return w.file.Close()
+}
+
+type Reader struct {
+	Header *pb.ReplayHeader
+	file   *os.File
+	buffer *bufio.Reader
+}
+
+func Open(filename string) (*Reader, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening replay file: %v", err)
+	}
+	r := &Reader{Header: &pb.ReplayHeader{}, file: file, buffer: bufio.NewReader(file)}
+	if err = readMessage(r.buffer, r.Header); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("Error reading replay header: %v", err)
+	}
+	return r, nil
+}
+
+// Next returns the next recorded tick or io.EOF when replay is over
+func (r *Reader) Next() (*pb.ReplayTick, error) {
+	tick := &pb.ReplayTick{}
+	if err := readMessage(r.buffer, tick); err != nil {
+		return nil, err
+	}
+	return tick, nil
+}
+
+func (r *Reader) Close() error {
+	return r.file.Close()
+}
+
+func writeMessage(w io.Writer, message proto.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("Error marshalling replay message: %v", err)
+	}
+	size := make([]byte, binary.MaxVarintLen64)
+	if _, err = w.Write(size[:binary.PutUvarint(size, uint64(len(data)))]); err != nil {
+		return fmt.Errorf("Error writing replay message: %v", err)
+	}
+	if _, err = w.Write(data); err != nil {
+		return fmt.Errorf("Error writing replay message: %v", err)
+	}
+	return nil
+}
+
+func readMessage(r *bufio.Reader, message proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if size > maxMessageSize {
+		return fmt.Errorf("replay message is too big: %v bytes", size)
+	}
+	data := make([]byte, size)
+	if _, err = io.ReadFull(r, data); err != nil {
+		return fmt.Errorf("Error reading replay message: %v", err)
+	}
+	if err = proto.Unmarshal(data, message); err != nil {
+		return fmt.Errorf("Error unmarshalling replay message: %v", err)
+	}
+	return nil
+}
+
+// Recorder writes inputs of every session tick and periodic keyframes of game state
+type Recorder struct {
+	writer           *Writer
+	keyframeInterval int
+}
+
+func NewRecorder(filename string, gs *gamesession.GameSession, keyframeInterval int) (*Recorder, error) {
+	header, err := gs.ReplayHeader(keyframeInterval)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := Create(filename, header)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{writer: writer, keyframeInterval: keyframeInterval}, nil
+}
+
+// RecordTick should be called by the goroutine running ticks right after each of them, the final tick always gets a keyframe
+func (r *Recorder) RecordTick(gs *gamesession.GameSession, final bool) error {
+	tick := &pb.ReplayTick{Tick: int64(gs.CurrentTick()), Inputs: gs.AppliedInputs()}
+	if final || (r.keyframeInterval > 0 && gs.CurrentTick()%r.keyframeInterval == 0) {
+		gs.RLock()
+		tick.Keyframe = gs.StateProto()
+		gs.RUnlock()
+	}
+	return r.writer.WriteTick(tick)
+}
+
+func (r *Recorder) Close() error {
+	return r.writer.Close()
+}
+
+// Playback simulates recorded match again and checks it against keyframes
+type Playback struct {
+	Session *gamesession.GameSession
+	reader  *Reader
+}
+
+func NewPlayback(reader *Reader) (*Playback, error) {
+	gs, err := gamesession.NewGameSessionFromReplay(reader.Header)
+	if err != nil {
+		return nil, err
+	}
+	return &Playback{Session: gs, reader: reader}, nil
+}
+
+// Step simulates the next recorded tick, returns io.EOF after the last one and ErrDesync when state differs from keyframe
+func (p *Playback) Step() (*pb.ReplayTick, error) {
+	tick, err := p.reader.Next()
+	if err != nil {
+		return nil, err
+	}
+	for _, input := range tick.Inputs {
+		p.Session.QueueInput(input)
+	}
+	p.Session.DoSessionTick()
+	drainNotifications(p.Session)
+	if int64(p.Session.CurrentTick()) != tick.Tick {
+		return nil, fmt.Errorf("%w: expected tick %v, simulated %v", ErrDesync, tick.Tick, p.Session.CurrentTick())
+	}
+	if tick.Keyframe != nil && !proto.Equal(tick.Keyframe, p.Session.StateProto()) {
+		return nil, fmt.Errorf("%w: keyframe mismatch at tick %v", ErrDesync, tick.Tick)
+	}
+	return tick, nil
+}
+
+// drainNotifications drops notifications nobody listens to during playback, otherwise session blocks on full channels
+func drainNotifications(gs *gamesession.GameSession) {
+	for {
+		select {
+		case <-gs.AttackNotifications:
+		case <-gs.KillNotifications:
+		case <-gs.AttackRejections:
+		case <-gs.HitEvents:
+		default:
+			return
+		}
+	}
+}
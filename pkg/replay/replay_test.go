@@ -0,0 +1,179 @@
+package replay
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+// recordTestMatch records players running into each other and attacking every attackEvery ticks,
+// returns session with number of successful attacks
+func recordTestMatch(t *testing.T, filename string, ticks, attackEvery int) (*gamesession.GameSession, int) {
+	absPath, _ := filepath.Abs("")
+	mapPath := filepath.Join(absPath[:len(absPath)-10], "/test/testmap.json")
+	gs, err := gamesession.NewGameSession(&gamesession.GameSessionConfig{
+		GameStatesSaved:     3,
+		GameStatesShiftBack: 1,
+		TicksPerSecond:      30,
+		PlayerCount:         2,
+		PlayerPickUpRange:   10,
+		PlayerDropRange:     12,
+		PlayerRadius:        5,
+		PlayerMaxSpeed:      30,
+		PlayerAcceleration:  150,
+		PlayerCollision:     true,
+		PlayerMaxStamina:    100,
+		StaminaRegen:        10,
+		AttackStaminaCost:   10,
+		AttackCooldowns:     true,
+		DefaultWeapon: &pb.EquipmentItem{
+			Type:   pb.EquipmentItemType_WEAPON,
+			Rarity: pb.EquipmentItemRarity_DEFAULT,
+			Characteristics: &pb.EquipmentItem_WeaponChars{
+				WeaponChars: &pb.WeaponCharacteristics{
+					AttackPower:    10,
+					KnockbackPower: 2,
+					Range:          7,
+					AttackCone:     0.79,
+					AttackInterval: 0.3,
+				},
+			},
+		},
+	}, mapPath)
+	if err != nil {
+		t.Fatalf("unable to create game session: %v", err)
+	}
+	gs.SetPlayerInfo("player0", "id-0", 0)
+	gs.SetPlayerInfo("player1", "id-1", 1)
+	gs.InitPrevGameStates()
+
+	recorder, err := NewRecorder(filename, gs, 10)
+	if err != nil {
+		t.Fatalf("unable to create recorder: %v", err)
+	}
+	attacks := 0
+	for i := 0; i < ticks; i++ {
+		gs.QueueAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{X: 1, Y: 1}, Angle: 0.05}}}, 0)
+		gs.QueueAction(&pb.Action{Action: &pb.Action_Move{Move: &pb.MovementAction{Direction: &pb.Vector{X: -1, Y: -1}}}}, 1)
+		if i%attackEvery == 0 {
+			gs.QueueAction(&pb.Action{Action: &pb.Action_Attack{Attack: &pb.AttackAction{}}}, 0)
+			gs.QueueAction(&pb.Action{Action: &pb.Action_Attack{Attack: &pb.AttackAction{}}}, 1)
+		}
+		endGame := gs.DoSessionTick()
+		attacks += len(gs.AttackNotifications)
+		drainNotifications(gs)
+		if err = recorder.RecordTick(gs, endGame || i == ticks-1); err != nil {
+			t.Fatalf("unable to record tick: %v", err)
+		}
+		if endGame {
+			break
+		}
+	}
+	if err = recorder.Close(); err != nil {
+		t.Fatalf("unable to close recorder: %v", err)
+	}
+	return gs, attacks
+}
+
+func TestReplay(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "match.replay")
+	gs, _ := recordTestMatch(t, filename, 90, 5)
+
+	reader, err := Open(filename)
+	if err != nil {
+		t.Fatalf("unable to open replay: %v", err)
+	}
+	defer reader.Close()
+	if reader.Header.Nicknames[1] != "player1" || reader.Header.KeyframeInterval != 10 {
+		t.Fatalf("unexpected replay header: %v", reader.Header)
+	}
+	playback, err := NewPlayback(reader)
+	if err != nil {
+		t.Fatalf("unable to start playback: %v", err)
+	}
+	ticks := 0
+	keyframes := 0
+	for {
+		tick, err := playback.Step()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected playback error: %v", err)
+		}
+		ticks++
+		if tick.Keyframe != nil {
+			keyframes++
+		}
+	}
+	if ticks != 90 || keyframes != 9 {
+		t.Fatalf("expected 90 ticks with 9 keyframes, got %v ticks and %v keyframes", ticks, keyframes)
+	}
+	if !proto.Equal(gs.StateProto(), playback.Session.StateProto()) {
+		t.Fatal("replayed state differs from recorded match")
+	}
+	if gs.StateProto().Players[1].Stats.Damage == 0 {
+		t.Fatal("expected players to fight during recorded match")
+	}
+
+	tampered, err := Open(filename)
+	if err != nil {
+		t.Fatalf("unable to open replay: %v", err)
+	}
+	defer tampered.Close()
+	tampered.Header.DefaultWeapon.GetWeaponChars().AttackPower++
+	playback, err = NewPlayback(tampered)
+	if err != nil {
+		t.Fatalf("unable to start playback: %v", err)
+	}
+	for {
+		if _, err = playback.Step(); err != nil {
+			break
+		}
+	}
+	if !errors.Is(err, ErrDesync) {
+		t.Fatalf("expected desync with different weapon, got: %v", err)
+	}
+}
+
+func TestReplayDrainsNotifications(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "match.replay")
+	gs, attacks := recordTestMatch(t, filename, 300, 1)
+	if attacks <= cap(gs.AttackNotifications) {
+		t.Fatalf("expected more attacks than notification buffer holds, got %v", attacks)
+	}
+
+	reader, err := Open(filename)
+	if err != nil {
+		t.Fatalf("unable to open replay: %v", err)
+	}
+	defer reader.Close()
+	playback, err := NewPlayback(reader)
+	if err != nil {
+		t.Fatalf("unable to start playback: %v", err)
+	}
+	finished := make(chan error, 1)
+	go func() {
+		for {
+			if _, err := playback.Step(); err != nil {
+				finished <- err
+				return
+			}
+		}
+	}()
+	select {
+	case err = <-finished:
+		if err != io.EOF {
+			t.Fatalf("unexpected playback error: %v", err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("playback got stuck on full notification channels")
+	}
+}
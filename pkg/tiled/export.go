@@ -0,0 +1,134 @@
+package tiled
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+)
+
+const (
+	LayerEntities = "entities"
+	LayerRegions  = "regions"
+	LayerPoints   = "points"
+)
+
+// exporter assigns ids to layers and objects of exported map
+type exporter struct {
+	nextLayerId  int
+	nextObjectId int
+}
+
+// Export converts game map description to Tiled JSON map with single pixel tiles, so existing maps can be edited in Tiled
+// and imported back
+func Export(mapDesc *gamesession.MapDescription) ([]byte, error) {
+	e := &exporter{nextLayerId: 1, nextObjectId: 1}
+	tiledMap := Map{
+		Type:        "map",
+		Version:     "1.10",
+		Orientation: "orthogonal",
+		RenderOrder: "right-down",
+		Width:       int(math.Ceil(float64(mapDesc.MapBorderX))),
+		Height:      int(math.Ceil(float64(mapDesc.MapBorderY))),
+		TileWidth:   1,
+		TileHeight:  1,
+		Tilesets:    []struct{}{},
+	}
+	properties, err := exportMapProperties(mapDesc)
+	if err != nil {
+		return nil, err
+	}
+	tiledMap.Properties = properties
+
+	entities := e.layer(LayerEntities)
+	for _, polygon := range mapDesc.Polygons {
+		object := e.polygon(polygon.Name, polygon.Type, polygon.Vertexes)
+		if polygon.Hp != 0 {
+			object.Properties = []Property{{Name: PropertyHp, Type: "int", Value: polygon.Hp}}
+		}
+		entities.Objects = append(entities.Objects, object)
+	}
+	regions := e.layer(LayerRegions)
+	for _, terrain := range mapDesc.Terrain {
+		object := e.polygon(terrain.Name, gamesession.RegionTerrain, terrain.Vertexes)
+		object.Properties = []Property{{Name: PropertySpeedModifier, Type: "float", Value: terrain.SpeedModifier}}
+		regions.Objects = append(regions.Objects, object)
+	}
+	for _, concealment := range mapDesc.Concealment {
+		regions.Objects = append(regions.Objects, e.polygon(concealment.Name, gamesession.RegionConcealment, concealment.Vertexes))
+	}
+	for _, hazard := range mapDesc.Hazards {
+		object := e.polygon(hazard.Name, gamesession.RegionHazard, hazard.Vertexes)
+		object.Properties = []Property{{Name: PropertyDamagePerSecond, Type: "float", Value: hazard.DamagePerSecond}}
+		regions.Objects = append(regions.Objects, object)
+	}
+	points := e.layer(LayerPoints)
+	for i := 0; i+1 < len(mapDesc.LootSpawns); i += 2 {
+		object := e.point(ClassLoot, mapDesc.LootSpawns[i], mapDesc.LootSpawns[i+1])
+		if i/2 < len(mapDesc.LootSpotNames) {
+			object.Name = mapDesc.LootSpotNames[i/2]
+		}
+		if i/2 < len(mapDesc.LootSpotTables) && mapDesc.LootSpotTables[i/2] != "" {
+			object.Properties = []Property{{Name: PropertyTable, Type: "string", Value: mapDesc.LootSpotTables[i/2]}}
+		}
+		points.Objects = append(points.Objects, object)
+	}
+	for i := 0; i+1 < len(mapDesc.PlayerSpawns); i += 2 {
+		points.Objects = append(points.Objects, e.point(ClassSpawn, mapDesc.PlayerSpawns[i], mapDesc.PlayerSpawns[i+1]))
+	}
+
+	tiledMap.Layers = []Layer{entities, regions, points}
+	tiledMap.NextLayerId, tiledMap.NextObjectId = e.nextLayerId, e.nextObjectId
+	return json.MarshalIndent(tiledMap, "", "  ")
+}
+
+func exportMapProperties(mapDesc *gamesession.MapDescription) ([]Property, error) {
+	var properties []Property
+	if mapDesc.Metadata.Name != "" {
+		properties = append(properties, Property{Name: PropertyName, Type: "string", Value: mapDesc.Metadata.Name})
+	}
+	if mapDesc.Metadata.Author != "" {
+		properties = append(properties, Property{Name: PropertyAuthor, Type: "string", Value: mapDesc.Metadata.Author})
+	}
+	if mapDesc.Metadata.RecommendedPlayers != 0 {
+		properties = append(properties, Property{Name: PropertyPlayers, Type: "int", Value: mapDesc.Metadata.RecommendedPlayers})
+	}
+	if mapDesc.SafeZone != nil {
+		safeZone, err := json.Marshal(mapDesc.SafeZone)
+		if err != nil {
+			return nil, fmt.Errorf("Error marshalling safe zone: %v", err)
+		}
+		properties = append(properties, Property{Name: PropertySafeZone, Type: "string", Value: string(safeZone)})
+	}
+	return properties, nil
+}
+
+func (e *exporter) layer(name string) Layer {
+	layer := Layer{Id: e.nextLayerId, Name: name, Type: "objectgroup", Visible: true, Opacity: 1, DrawOrder: "topdown"}
+	e.nextLayerId++
+	return layer
+}
+
+func (e *exporter) object(name, class string) Object {
+	object := Object{Id: e.nextObjectId, Name: name, Class: class, Visible: true}
+	e.nextObjectId++
+	return object
+}
+
+// polygon places polygon object at the origin, so its points keep absolute coordinates
+func (e *exporter) polygon(name, class string, vertexes []float64) Object {
+	object := e.object(name, class)
+	object.Polygon = make([]Point, 0, len(vertexes)/2)
+	for i := 0; i+1 < len(vertexes); i += 2 {
+		object.Polygon = append(object.Polygon, Point{X: vertexes[i], Y: vertexes[i+1]})
+	}
+	return object
+}
+
+func (e *exporter) point(class string, x, y float32) Object {
+	object := e.object("", class)
+	object.Point = true
+	object.X, object.Y = float64(x), float64(y)
+	return object
+}
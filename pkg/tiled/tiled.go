@@ -0,0 +1,318 @@
+package tiled
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+	"github.com/amikhailau/medieval-game-server/pkg/pb"
+)
+
+const (
+	ClassLoot  = "loot"
+	ClassSpawn = "spawn"
+
+	PropertyTable           = "table"
+	PropertyHp              = "hp"
+	PropertySpeedModifier   = "speed_modifier"
+	PropertyDamagePerSecond = "damage_per_second"
+	PropertyName            = "name"
+	PropertyAuthor          = "author"
+	PropertyPlayers         = "recommended_players"
+	PropertySafeZone        = "safe_zone"
+)
+
+// ellipseSegments is amount of vertexes of polygon approximating ellipse object
+const ellipseSegments = 16
+
+// coordinatePrecision is used to round coordinates, so rotated objects do not get float noise
+const coordinatePrecision = 1e4
+
+// Map is subset of Tiled JSON map format used by the importer
+type Map struct {
+	Type         string     `json:"type"`
+	Version      string     `json:"version"`
+	TiledVersion string     `json:"tiledversion,omitempty"`
+	Orientation  string     `json:"orientation"`
+	RenderOrder  string     `json:"renderorder,omitempty"`
+	Infinite     bool       `json:"infinite"`
+	Width        int        `json:"width"`
+	Height       int        `json:"height"`
+	TileWidth    int        `json:"tilewidth"`
+	TileHeight   int        `json:"tileheight"`
+	NextLayerId  int        `json:"nextlayerid"`
+	NextObjectId int        `json:"nextobjectid"`
+	Layers       []Layer    `json:"layers"`
+	Tilesets     []struct{} `json:"tilesets"`
+	Properties   []Property `json:"properties,omitempty"`
+}
+
+type Layer struct {
+	Id        int      `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Visible   bool     `json:"visible"`
+	Opacity   float64  `json:"opacity"`
+	X         int      `json:"x"`
+	Y         int      `json:"y"`
+	OffsetX   float64  `json:"offsetx,omitempty"`
+	OffsetY   float64  `json:"offsety,omitempty"`
+	DrawOrder string   `json:"draworder,omitempty"`
+	Objects   []Object `json:"objects,omitempty"`
+	Layers    []Layer  `json:"layers,omitempty"`
+}
+
+type Object struct {
+	Id         int        `json:"id"`
+	Name       string     `json:"name"`
+	Type       string     `json:"type,omitempty"`
+	Class      string     `json:"class,omitempty"`
+	X          float64    `json:"x"`
+	Y          float64    `json:"y"`
+	Width      float64    `json:"width"`
+	Height     float64    `json:"height"`
+	Rotation   float64    `json:"rotation"`
+	Visible    bool       `json:"visible"`
+	Point      bool       `json:"point,omitempty"`
+	Ellipse    bool       `json:"ellipse,omitempty"`
+	Polygon    []Point    `json:"polygon,omitempty"`
+	Polyline   []Point    `json:"polyline,omitempty"`
+	Properties []Property `json:"properties,omitempty"`
+}
+
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type Property struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// class returns object class, Tiled before 1.9 stores it as type
+func (o *Object) class() string {
+	if o.Class != "" {
+		return o.Class
+	}
+	return o.Type
+}
+
+func findProperty(properties []Property, name string) (interface{}, bool) {
+	for _, property := range properties {
+		if property.Name == name {
+			return property.Value, true
+		}
+	}
+	return nil, false
+}
+
+func stringProperty(properties []Property, name string) (string, error) {
+	value, ok := findProperty(properties, name)
+	if !ok {
+		return "", nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("property %v should be string", name)
+	}
+	return str, nil
+}
+
+func numberProperty(properties []Property, name string) (float64, error) {
+	value, ok := findProperty(properties, name)
+	if !ok {
+		return 0, nil
+	}
+	number, ok := value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("property %v should be number", name)
+	}
+	return number, nil
+}
+
+// Import converts Tiled JSON map to game map description, object layers become map entities and regions,
+// point objects of loot and spawn classes become loot spots and player spawns
+func Import(data []byte) (*gamesession.MapDescription, error) {
+	var tiledMap Map
+	if err := json.Unmarshal(data, &tiledMap); err != nil {
+		return nil, fmt.Errorf("Error unmarshalling tiled map: %v", err)
+	}
+	if tiledMap.Infinite {
+		return nil, fmt.Errorf("infinite tiled maps are not supported")
+	}
+	if tiledMap.Orientation != "" && tiledMap.Orientation != "orthogonal" {
+		return nil, fmt.Errorf("%v tiled maps are not supported", tiledMap.Orientation)
+	}
+	mapDesc := &gamesession.MapDescription{
+		MapBorderX: float32(tiledMap.Width * tiledMap.TileWidth),
+		MapBorderY: float32(tiledMap.Height * tiledMap.TileHeight),
+	}
+	if err := importMapProperties(mapDesc, tiledMap.Properties); err != nil {
+		return nil, err
+	}
+	if err := importLayers(mapDesc, tiledMap.Layers, 0, 0); err != nil {
+		return nil, err
+	}
+	if strings.Join(mapDesc.LootSpotTables, "") == "" {
+		mapDesc.LootSpotTables = nil
+	}
+	if strings.Join(mapDesc.LootSpotNames, "") == "" {
+		mapDesc.LootSpotNames = nil
+	}
+	return mapDesc, nil
+}
+
+func importMapProperties(mapDesc *gamesession.MapDescription, properties []Property) error {
+	var err error
+	if mapDesc.Metadata.Name, err = stringProperty(properties, PropertyName); err != nil {
+		return err
+	}
+	if mapDesc.Metadata.Author, err = stringProperty(properties, PropertyAuthor); err != nil {
+		return err
+	}
+	players, err := numberProperty(properties, PropertyPlayers)
+	if err != nil {
+		return err
+	}
+	mapDesc.Metadata.RecommendedPlayers = int(players)
+	safeZone, err := stringProperty(properties, PropertySafeZone)
+	if err != nil {
+		return err
+	}
+	if safeZone != "" {
+		mapDesc.SafeZone = &gamesession.SafeZoneJSON{}
+		if err = json.Unmarshal([]byte(safeZone), mapDesc.SafeZone); err != nil {
+			return fmt.Errorf("Error unmarshalling safe zone property: %v", err)
+		}
+	}
+	return nil
+}
+
+func importLayers(mapDesc *gamesession.MapDescription, layers []Layer, offsetX, offsetY float64) error {
+	for _, layer := range layers {
+		layerOffsetX, layerOffsetY := offsetX+layer.OffsetX, offsetY+layer.OffsetY
+		switch layer.Type {
+		case "group":
+			if err := importLayers(mapDesc, layer.Layers, layerOffsetX, layerOffsetY); err != nil {
+				return err
+			}
+		case "objectgroup":
+			for _, object := range layer.Objects {
+				if err := importObject(mapDesc, &object, layerOffsetX, layerOffsetY); err != nil {
+					return fmt.Errorf("layer %q, object %v: %v", layer.Name, object.Id, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func importObject(mapDesc *gamesession.MapDescription, object *Object, offsetX, offsetY float64) error {
+	class := object.class()
+	if object.Point {
+		x, y := float32(round(object.X+offsetX)), float32(round(object.Y+offsetY))
+		switch class {
+		case ClassLoot:
+			table, err := stringProperty(object.Properties, PropertyTable)
+			if err != nil {
+				return err
+			}
+			mapDesc.LootSpawns = append(mapDesc.LootSpawns, x, y)
+			mapDesc.LootSpotTables = append(mapDesc.LootSpotTables, table)
+			mapDesc.LootSpotNames = append(mapDesc.LootSpotNames, object.Name)
+		case ClassSpawn:
+			mapDesc.PlayerSpawns = append(mapDesc.PlayerSpawns, x, y)
+		default:
+			return fmt.Errorf("point should have class %v or %v, got %q", ClassLoot, ClassSpawn, class)
+		}
+		return nil
+	}
+
+	vertexes, err := objectVertexes(object, offsetX, offsetY)
+	if err != nil {
+		return err
+	}
+	switch class {
+	case gamesession.RegionTerrain:
+		speedModifier, err := numberProperty(object.Properties, PropertySpeedModifier)
+		if err != nil {
+			return err
+		}
+		mapDesc.Terrain = append(mapDesc.Terrain, gamesession.TerrainJSON{Name: object.Name, Vertexes: vertexes, SpeedModifier: float32(speedModifier)})
+	case gamesession.RegionConcealment:
+		mapDesc.Concealment = append(mapDesc.Concealment, gamesession.ConcealmentJSON{Name: object.Name, Vertexes: vertexes})
+	case gamesession.RegionHazard:
+		damage, err := numberProperty(object.Properties, PropertyDamagePerSecond)
+		if err != nil {
+			return err
+		}
+		mapDesc.Hazards = append(mapDesc.Hazards, gamesession.HazardJSON{Name: object.Name, Vertexes: vertexes, DamagePerSecond: float32(damage)})
+	default:
+		entityType := strings.ToUpper(class)
+		if _, ok := pb.MapEntityType_value[entityType]; class != "" && !ok {
+			return fmt.Errorf("unknown class %q", class)
+		}
+		if entityType == pb.MapEntityType_WALL.String() {
+			entityType = ""
+		}
+		hp, err := numberProperty(object.Properties, PropertyHp)
+		if err != nil {
+			return err
+		}
+		mapDesc.Polygons = append(mapDesc.Polygons, gamesession.PolygonJSON{Name: object.Name, Vertexes: vertexes, Type: entityType, Hp: int32(hp)})
+	}
+	return nil
+}
+
+// objectVertexes returns absolute counter-clockwise vertexes of rectangle, ellipse or polygon object
+func objectVertexes(object *Object, offsetX, offsetY float64) ([]float64, error) {
+	var points []Point
+	switch {
+	case object.Polyline != nil:
+		return nil, fmt.Errorf("polylines are not supported, use polygons")
+	case object.Polygon != nil:
+		points = object.Polygon
+	case object.Ellipse:
+		radiusX, radiusY := object.Width/2, object.Height/2
+		for i := 0; i < ellipseSegments; i++ {
+			angle := 2 * math.Pi * float64(i) / ellipseSegments
+			points = append(points, Point{X: radiusX + radiusX*math.Cos(angle), Y: radiusY + radiusY*math.Sin(angle)})
+		}
+	default:
+		points = []Point{{0, 0}, {object.Width, 0}, {object.Width, object.Height}, {0, object.Height}}
+	}
+	if len(points) < 3 {
+		return nil, fmt.Errorf("shape should have at least 3 vertexes")
+	}
+
+	rotation := object.Rotation * math.Pi / 180
+	sin, cos := math.Sin(rotation), math.Cos(rotation)
+	vertexes := make([]float64, 0, len(points)*2)
+	area := 0.0
+	for i, point := range points {
+		next := points[(i+1)%len(points)]
+		area += point.X*next.Y - next.X*point.Y
+		x := object.X + offsetX + point.X*cos - point.Y*sin
+		y := object.Y + offsetY + point.X*sin + point.Y*cos
+		vertexes = append(vertexes, round(x), round(y))
+	}
+	if area < 0 {
+		reverseVertexes(vertexes)
+	}
+	return vertexes, nil
+}
+
+func reverseVertexes(vertexes []float64) {
+	for i, j := 0, len(vertexes)-2; i < j; i, j = i+2, j-2 {
+		vertexes[i], vertexes[j] = vertexes[j], vertexes[i]
+		vertexes[i+1], vertexes[j+1] = vertexes[j+1], vertexes[i+1]
+	}
+}
+
+func round(value float64) float64 {
+	return math.Round(value*coordinatePrecision) / coordinatePrecision
+}
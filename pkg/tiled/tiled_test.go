@@ -0,0 +1,123 @@
+package tiled
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/amikhailau/medieval-game-server/pkg/gamesession"
+)
+
+func testDataPath(filename string) string {
+	absPath, _ := filepath.Abs("")
+	return filepath.Join(absPath[:len(absPath)-9], "/test", filename)
+}
+
+func TestImport(t *testing.T) {
+	data, err := ioutil.ReadFile(testDataPath("tiled/testmap.tmj"))
+	if err != nil {
+		t.Fatalf("unable to read tiled map: %v", err)
+	}
+	imported, err := Import(data)
+	if err != nil {
+		t.Fatalf("unable to import tiled map: %v", err)
+	}
+	expected, err := gamesession.LoadMap(testDataPath("testmap.json"), 5, 1)
+	if err != nil {
+		t.Fatalf("unable to load map: %v", err)
+	}
+	if !reflect.DeepEqual(imported, expected) {
+		t.Fatalf("imported map differs from test map:\n%+v\n%+v", imported, expected)
+	}
+	if err = gamesession.ValidateMap(imported, 5); err != nil {
+		t.Fatalf("imported map is invalid: %v", err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	mapDesc, err := gamesession.LoadMap(testDataPath("testmap.json"), 5, 1)
+	if err != nil {
+		t.Fatalf("unable to load map: %v", err)
+	}
+	mapDesc.Metadata = gamesession.MapMetadataJSON{Name: "test", Author: "tests", RecommendedPlayers: 2}
+	mapDesc.LootSpotTables = []string{"low_tier", "", "high_tier", ""}
+	mapDesc.LootSpotNames = []string{"barn", "", "", "tower"}
+	mapDesc.Hazards = []gamesession.HazardJSON{{Name: "fire", Vertexes: []float64{37, 37, 43, 37, 43, 43, 37, 43}, DamagePerSecond: 10}}
+
+	data, err := Export(mapDesc)
+	if err != nil {
+		t.Fatalf("unable to export map: %v", err)
+	}
+	imported, err := Import(data)
+	if err != nil {
+		t.Fatalf("unable to import exported map: %v", err)
+	}
+	if !reflect.DeepEqual(imported, mapDesc) {
+		t.Fatalf("map changed after export and import:\n%+v\n%+v", imported, mapDesc)
+	}
+}
+
+func TestImportShapes(t *testing.T) {
+	shapes := `{"width": 10, "height": 10, "tilewidth": 10, "tileheight": 10, "layers": [{"type": "objectgroup", "offsetx": 5, "objects": [
+		{"id": 1, "x": 50, "y": 50, "width": 10, "height": 20, "rotation": 90},
+		{"id": 2, "x": 10, "y": 10, "width": 20, "height": 10, "ellipse": true}
+	]}]}`
+	mapDesc, err := Import([]byte(shapes))
+	if err != nil {
+		t.Fatalf("unable to import shapes: %v", err)
+	}
+	if len(mapDesc.Polygons) != 2 {
+		t.Fatalf("expected 2 entities, got %v", len(mapDesc.Polygons))
+	}
+	if rotated := mapDesc.Polygons[0].Vertexes; !reflect.DeepEqual(rotated, []float64{55, 50, 55, 60, 35, 60, 35, 50}) {
+		t.Fatalf("unexpected vertexes of rotated rectangle: %v", rotated)
+	}
+	ellipse := mapDesc.Polygons[1].Vertexes
+	if len(ellipse) != ellipseSegments*2 {
+		t.Fatalf("expected %v ellipse vertexes, got %v", ellipseSegments, len(ellipse)/2)
+	}
+	for i := 0; i < len(ellipse); i += 2 {
+		if ellipse[i] < 15 || ellipse[i] > 35 || ellipse[i+1] < 10 || ellipse[i+1] > 20 {
+			t.Fatalf("ellipse vertex %v, %v is outside of its bounds", ellipse[i], ellipse[i+1])
+		}
+	}
+	mapDesc.PlayerSpawns = nil
+	if err = gamesession.ValidateMap(mapDesc, 5); err != nil {
+		t.Fatalf("imported shapes are invalid: %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		tiledMap string
+		err      string
+	}{
+		"infinite": {
+			tiledMap: `{"infinite": true}`,
+			err:      "infinite",
+		},
+		"polyline": {
+			tiledMap: `{"layers": [{"type": "objectgroup", "objects": [{"id": 1, "polyline": [{"x": 0, "y": 0}, {"x": 10, "y": 0}]}]}]}`,
+			err:      "polylines are not supported",
+		},
+		"unknown class": {
+			tiledMap: `{"layers": [{"type": "objectgroup", "objects": [{"id": 1, "class": "tree", "width": 5, "height": 5}]}]}`,
+			err:      "unknown class",
+		},
+		"point class": {
+			tiledMap: `{"layers": [{"type": "objectgroup", "objects": [{"id": 1, "point": true}]}]}`,
+			err:      "point should have class",
+		},
+		"property type": {
+			tiledMap: `{"layers": [{"type": "objectgroup", "objects": [{"id": 1, "class": "crate", "width": 5, "height": 5, "properties": [{"name": "hp", "type": "string", "value": "20"}]}]}]}`,
+			err:      "property hp should be number",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Import([]byte(tc.tiledMap))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got: %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/amikhailau/medieval-game-server/pkg/allocation"
+	"github.com/amikhailau/medieval-game-server/pkg/mappool"
 	"github.com/amikhailau/medieval-game-server/pkg/pb"
 	"github.com/golang/protobuf/ptypes"
 	"google.golang.org/grpc"
@@ -18,14 +20,21 @@ const (
 	TestUserID   = "some-user-id"
 )
 
+var flagMapId = flag.String("map", "", "id of map from map pool to play, chosen by server if empty")
+
 func main() {
+	flag.Parse()
 
 	agonesClient, err := allocation.ConnectToAgonesLocal()
 	if err != nil {
 		log.Fatalf("unable to connect to agones: %v", err)
 	}
 
-	gsa, err := allocation.AllocateGameServer(agonesClient)
+	var annotations map[string]string
+	if *flagMapId != "" {
+		annotations = map[string]string{mappool.MapKey: *flagMapId}
+	}
+	gsa, err := allocation.AllocateGameServer(agonesClient, annotations)
 	if err != nil {
 		log.Fatalf("unable to allocate server to test: %v", err)
 	}
@@ -51,5 +60,5 @@ func main() {
 		log.Fatalf("unable to send connect request server to test: %v", err)
 	}
 
-	fmt.Printf("Response from server:\n\tClientToken: %v\n\tPing: %v\n", resp.Token, resp.Ping)
+	fmt.Printf("Response from server:\n\tClientToken: %v\n\tPing: %v\n\tMap: %v\n", resp.Token, resp.Ping, resp.MapId)
 }